@@ -31,6 +31,7 @@ const (
 	ChytReleaseStatusCreatingUser           ChytReleaseStatus = "CreatingUser"
 	ChytReleaseStatusUploadingIntoCypress   ChytReleaseStatus = "UploadingIntoCypress"
 	ChytReleaseStatusCreatingChPublicClique ChytReleaseStatus = "CreatingChPublicClique"
+	ChytReleaseStatusRestartingCliques      ChytReleaseStatus = "RestartingCliques"
 	ChytReleaseStatusFinished               ChytReleaseStatus = "Finished"
 )
 
@@ -48,10 +49,19 @@ type ChytSpec struct {
 type ChytStatus struct {
 	Conditions    []metav1.Condition `json:"conditions,omitempty"`
 	ReleaseStatus ChytReleaseStatus  `json:"releaseStatus,omitempty"`
+	// Image of the current or the last release, a change of the image in the spec triggers a new release.
+	ObservedImage      string `json:"observedImage,omitempty"`
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	// Image the running release jobs were started with, it is recorded as observed once they complete.
+	ReleasingImage string `json:"releasingImage,omitempty"`
+	// Released images, the most recent is the last. Older releases are kept in Cypress,
+	// setting one of these images back in the spec rolls back to it.
+	ReleasedImages []string `json:"releasedImages,omitempty"`
 }
 
 //+kubebuilder:object:root=true
 // +kubebuilder:printcolumn:name="ReleaseStatus",type="string",JSONPath=".status.releaseStatus",description="Status of release"
+// +kubebuilder:printcolumn:name="Image",type="string",JSONPath=".status.observedImage",description="Image of the current release"
 //+kubebuilder:subresource:status
 
 // Chyt is the Schema for the chyts API
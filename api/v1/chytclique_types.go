@@ -0,0 +1,97 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type ChytCliqueACE struct {
+	//+kubebuilder:validation:Enum={"allow","deny"}
+	//+kubebuilder:default:=allow
+	Action string `json:"action,omitempty"`
+	//+kubebuilder:validation:MinItems=1
+	Subjects []string `json:"subjects"`
+	//+kubebuilder:validation:MinItems=1
+	Permissions []string `json:"permissions"`
+}
+
+// ChytCliqueSpec defines the desired state of ChytClique
+type ChytCliqueSpec struct {
+	Ytsaurus corev1.LocalObjectReference `json:"ytsaurus"`
+
+	// Alias of the clique, the name of the resource is used by default.
+	//+optional
+	Alias string `json:"alias,omitempty"`
+
+	//+kubebuilder:default:=1
+	//+kubebuilder:validation:Minimum=1
+	InstanceCount int32 `json:"instanceCount,omitempty"`
+	//+kubebuilder:default:=1
+	//+kubebuilder:validation:Minimum=1
+	InstanceCPU int32 `json:"instanceCpu,omitempty"`
+	// Total memory of an instance, the default of the strawberry controller is used if not set.
+	//+optional
+	InstanceMemory *resource.Quantity `json:"instanceMemory,omitempty"`
+
+	// Pool to run the clique operation in, the clique is started untracked if not set.
+	//+optional
+	Pool string `json:"pool,omitempty"`
+
+	// Access control list of the clique, it is left as is if empty.
+	//+optional
+	ACL []ChytCliqueACE `json:"acl,omitempty"`
+
+	//+kubebuilder:default:=false
+	Stopped bool `json:"stopped"`
+}
+
+// ChytCliqueStatus defines the observed state of ChytClique
+type ChytCliqueStatus struct {
+	Conditions         []metav1.Condition `json:"conditions,omitempty"`
+	ObservedGeneration int64              `json:"observedGeneration,omitempty"`
+	State              string             `json:"state,omitempty"`
+	Health             string             `json:"health,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state",description="State of the clique"
+//+kubebuilder:printcolumn:name="Health",type="string",JSONPath=".status.health",description="Health of the clique"
+//+kubebuilder:subresource:status
+
+// ChytClique is the Schema for the chytcliques API
+type ChytClique struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ChytCliqueSpec   `json:"spec,omitempty"`
+	Status ChytCliqueStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ChytCliqueList contains a list of ChytClique
+type ChytCliqueList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ChytClique `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ChytClique{}, &ChytCliqueList{})
+}
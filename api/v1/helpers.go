@@ -1,5 +1,9 @@
 package v1
 
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
 func FindFirstLocation(locations []LocationSpec, locationType LocationType) *LocationSpec {
 	for _, location := range locations {
 		if location.LocationType == locationType {
@@ -18,3 +22,8 @@ func FindAllLocations(locations []LocationSpec, locationType LocationType) []Loc
 	}
 	return result
 }
+
+// IsDualStack checks if the components should use both IPv4 and IPv6.
+func IsDualStack(commonSpec *CommonSpec) bool {
+	return commonSpec.IPFamilyPolicy != nil && *commonSpec.IPFamilyPolicy != corev1.IPFamilyPolicySingleStack
+}
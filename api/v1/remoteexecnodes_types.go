@@ -0,0 +1,70 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RemoteExecNodesSpec defines the desired state of RemoteExecNodes
+type RemoteExecNodesSpec struct {
+	// ClusterConnection of the cluster to attach the nodes to, in YSON,
+	// as it is generated by the operator for //sys/@cluster_connection.
+	//+kubebuilder:validation:MinLength:=1
+	ClusterConnection string `json:"clusterConnection"`
+
+	// Encryption of the RPC traffic with the cluster. The mode is applied at once, so it should be
+	// optional while the internal transport of the cluster is switched between disabled and required.
+	//+optional
+	InternalTransport *InternalTransportSpec `json:"internalTransport,omitempty"`
+
+	CommonSpec    `json:",inline"`
+	ExecNodesSpec `json:",inline"`
+}
+
+// RemoteExecNodesStatus defines the observed state of RemoteExecNodes
+type RemoteExecNodesStatus struct {
+	Conditions         []metav1.Condition `json:"conditions,omitempty"`
+	ObservedGeneration int64              `json:"observedGeneration,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:printcolumn:name="Instances",type="integer",JSONPath=".spec.instanceCount",description="Number of exec nodes"
+//+kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status",description="Whether all the nodes are running"
+//+kubebuilder:subresource:status
+
+// RemoteExecNodes is the Schema for the remoteexecnodes API
+type RemoteExecNodes struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RemoteExecNodesSpec   `json:"spec,omitempty"`
+	Status RemoteExecNodesStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// RemoteExecNodesList contains a list of RemoteExecNodes
+type RemoteExecNodesList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RemoteExecNodes `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RemoteExecNodes{}, &RemoteExecNodesList{})
+}
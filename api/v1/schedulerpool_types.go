@@ -0,0 +1,102 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type PoolResources struct {
+	CPU       *resource.Quantity `json:"cpu,omitempty"`
+	Memory    *resource.Quantity `json:"memory,omitempty"`
+	GPU       *int               `json:"gpu,omitempty"`
+	UserSlots *int               `json:"userSlots,omitempty"`
+}
+
+type IntegralGuaranteesSpec struct {
+	//+kubebuilder:validation:Enum={"none","burst","relaxed"}
+	GuaranteeType           string         `json:"guaranteeType,omitempty"`
+	BurstGuaranteeResources *PoolResources `json:"burstGuaranteeResources,omitempty"`
+	ResourceFlow            *PoolResources `json:"resourceFlow,omitempty"`
+}
+
+// SchedulerPoolSpec defines the desired state of SchedulerPool
+type SchedulerPoolSpec struct {
+	Ytsaurus corev1.LocalObjectReference `json:"ytsaurus"`
+
+	// Name of the pool, the name of the resource is used by default.
+	//+optional
+	PoolName string `json:"poolName,omitempty"`
+	//+kubebuilder:default:=default
+	PoolTree string `json:"poolTree,omitempty"`
+	// Name of the parent pool, the pool is created in the root of the tree by default.
+	//+optional
+	ParentPool string `json:"parentPool,omitempty"`
+
+	//+kubebuilder:validation:Enum={"fair_share","fifo"}
+	//+optional
+	Mode string `json:"mode,omitempty"`
+	//+optional
+	Weight *resource.Quantity `json:"weight,omitempty"`
+	//+optional
+	StrongGuaranteeResources *PoolResources `json:"strongGuaranteeResources,omitempty"`
+	//+optional
+	IntegralGuarantees *IntegralGuaranteesSpec `json:"integralGuarantees,omitempty"`
+	//+kubebuilder:validation:Minimum=0
+	//+optional
+	MaxOperationCount *int `json:"maxOperationCount,omitempty"`
+	//+kubebuilder:validation:Minimum=0
+	//+optional
+	MaxRunningOperationCount *int `json:"maxRunningOperationCount,omitempty"`
+	//+optional
+	SchedulingTagFilter *string `json:"schedulingTagFilter,omitempty"`
+}
+
+// SchedulerPoolStatus defines the observed state of SchedulerPool
+type SchedulerPoolStatus struct {
+	Conditions         []metav1.Condition `json:"conditions,omitempty"`
+	ObservedGeneration int64              `json:"observedGeneration,omitempty"`
+	Path               string             `json:"path,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:printcolumn:name="Path",type="string",JSONPath=".status.path",description="Path of the pool in Cypress"
+//+kubebuilder:subresource:status
+
+// SchedulerPool is the Schema for the schedulerpools API
+type SchedulerPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SchedulerPoolSpec   `json:"spec,omitempty"`
+	Status SchedulerPoolStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// SchedulerPoolList contains a list of SchedulerPool
+type SchedulerPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SchedulerPool `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SchedulerPool{}, &SchedulerPoolList{})
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SchedulerPoolTreeSpec defines the desired state of SchedulerPoolTree
+type SchedulerPoolTreeSpec struct {
+	Ytsaurus corev1.LocalObjectReference `json:"ytsaurus"`
+
+	// Name of the pool tree, the name of the resource is used by default.
+	//+optional
+	TreeName string `json:"treeName,omitempty"`
+	// Make this tree the default one for operations without an explicit tree.
+	//+kubebuilder:default:=false
+	Default bool `json:"default"`
+
+	//+optional
+	NodesFilter *string `json:"nodesFilter,omitempty"`
+	//+optional
+	DefaultParentPool *string `json:"defaultParentPool,omitempty"`
+	//+kubebuilder:validation:Minimum=0
+	//+optional
+	MaxOperationCountPerPool *int `json:"maxOperationCountPerPool,omitempty"`
+	//+kubebuilder:validation:Minimum=0
+	//+optional
+	MaxRunningOperationCountPerPool *int `json:"maxRunningOperationCountPerPool,omitempty"`
+}
+
+// SchedulerPoolTreeStatus defines the observed state of SchedulerPoolTree
+type SchedulerPoolTreeStatus struct {
+	Conditions         []metav1.Condition `json:"conditions,omitempty"`
+	ObservedGeneration int64              `json:"observedGeneration,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// SchedulerPoolTree is the Schema for the schedulerpooltrees API
+type SchedulerPoolTree struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SchedulerPoolTreeSpec   `json:"spec,omitempty"`
+	Status SchedulerPoolTreeStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// SchedulerPoolTreeList contains a list of SchedulerPoolTree
+type SchedulerPoolTreeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SchedulerPoolTree `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SchedulerPoolTree{}, &SchedulerPoolTreeList{})
+}
@@ -45,6 +45,14 @@ type SpytSpec struct {
 type SpytStatus struct {
 	Conditions    []metav1.Condition `json:"conditions,omitempty"`
 	ReleaseStatus SpytReleaseStatus  `json:"releaseStatus,omitempty"`
+	// Image of the current or the last release, a change of the image in the spec triggers a new release.
+	ObservedImage      string `json:"observedImage,omitempty"`
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	// Image the running release jobs were started with, it is recorded as observed once they complete.
+	ReleasingImage string `json:"releasingImage,omitempty"`
+	// Released images, the most recent is the last. Older releases are kept in Cypress,
+	// setting one of these images back in the spec rolls back to it.
+	ReleasedImages []string `json:"releasedImages,omitempty"`
 }
 
 //+kubebuilder:rbac:groups=cluster.ytsaurus.tech,resources=spyts,verbs=get;list;watch;create;update;patch;delete
@@ -53,6 +61,7 @@ type SpytStatus struct {
 
 //+kubebuilder:object:root=true
 // +kubebuilder:printcolumn:name="ReleaseStatus",type="string",JSONPath=".status.releaseStatus",description="Status of release"
+// +kubebuilder:printcolumn:name="Image",type="string",JSONPath=".status.observedImage",description="Image of the current release"
 //+kubebuilder:subresource:status
 
 // Spyt is the Schema for the spyts API
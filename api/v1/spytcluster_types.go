@@ -0,0 +1,93 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SpytClusterSpec defines the desired state of SpytCluster
+type SpytClusterSpec struct {
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	Ytsaurus corev1.LocalObjectReference `json:"ytsaurus"`
+	// Image with spark-launch-yt, it is used to launch the cluster operation.
+	Image string `json:"image"`
+
+	// Discovery path of the cluster, //home/spark/discovery/<name> is used by default.
+	//+optional
+	DiscoveryPath string `json:"discoveryPath,omitempty"`
+
+	//+kubebuilder:default:=1
+	//+kubebuilder:validation:Minimum=1
+	WorkerCount int32 `json:"workerCount,omitempty"`
+	//+kubebuilder:default:=1
+	//+kubebuilder:validation:Minimum=1
+	WorkerCores int32 `json:"workerCores,omitempty"`
+	//+kubebuilder:default:="4Gi"
+	WorkerMemory resource.Quantity `json:"workerMemory,omitempty"`
+
+	//+optional
+	Pool string `json:"pool,omitempty"`
+
+	//+kubebuilder:default:=true
+	EnableHistoryServer bool `json:"enableHistoryServer"`
+}
+
+// SpytClusterStatus defines the observed state of SpytCluster
+type SpytClusterStatus struct {
+	Conditions         []metav1.Condition `json:"conditions,omitempty"`
+	ObservedGeneration int64              `json:"observedGeneration,omitempty"`
+
+	OperationID    string `json:"operationId,omitempty"`
+	OperationState string `json:"operationState,omitempty"`
+	// Number of restarts of the cluster operation after failures or spec changes.
+	Restarts int32 `json:"restarts,omitempty"`
+
+	MasterAddress        string `json:"masterAddress,omitempty"`
+	MasterWebUI          string `json:"masterWebUI,omitempty"`
+	HistoryServerAddress string `json:"historyServerAddress,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.operationState",description="State of the cluster operation"
+//+kubebuilder:printcolumn:name="Master",type="string",JSONPath=".status.masterAddress",description="Address of the Spark master"
+//+kubebuilder:printcolumn:name="Restarts",type="integer",JSONPath=".status.restarts",description="Restarts of the cluster operation"
+//+kubebuilder:subresource:status
+
+// SpytCluster is the Schema for the spytclusters API
+type SpytCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SpytClusterSpec   `json:"spec,omitempty"`
+	Status SpytClusterStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// SpytClusterList contains a list of SpytCluster
+type SpytClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SpytCluster `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SpytCluster{}, &SpytClusterList{})
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TabletCellBundleSpec defines the desired state of TabletCellBundle
+type TabletCellBundleSpec struct {
+	Ytsaurus corev1.LocalObjectReference `json:"ytsaurus"`
+
+	// Name of the bundle in Cypress, the name of the resource is used by default.
+	//+optional
+	BundleName string `json:"bundleName,omitempty"`
+
+	// Tablet cells are only created by the operator, extra cells are never removed.
+	//+kubebuilder:default:=1
+	//+kubebuilder:validation:Minimum=0
+	TabletCellCount int `json:"tabletCellCount"`
+
+	//+optional
+	NodeTagFilter *string `json:"nodeTagFilter,omitempty"`
+
+	//+kubebuilder:default:=sys
+	ChangelogAccount string `json:"changelogAccount,omitempty"`
+	//+kubebuilder:default:=sys
+	SnapshotAccount string `json:"snapshotAccount,omitempty"`
+
+	//+optional
+	ChangelogPrimaryMedium *string `json:"changelogPrimaryMedium,omitempty"`
+	//+optional
+	SnapshotPrimaryMedium *string `json:"snapshotPrimaryMedium,omitempty"`
+
+	//+kubebuilder:validation:Minimum=1
+	//+optional
+	ChangelogReplicationFactor *int `json:"changelogReplicationFactor,omitempty"`
+	//+kubebuilder:validation:Minimum=1
+	//+optional
+	SnapshotReplicationFactor *int `json:"snapshotReplicationFactor,omitempty"`
+}
+
+// TabletCellBundleStatus defines the observed state of TabletCellBundle
+type TabletCellBundleStatus struct {
+	Conditions         []metav1.Condition `json:"conditions,omitempty"`
+	ObservedGeneration int64              `json:"observedGeneration,omitempty"`
+	Health             string             `json:"health,omitempty"`
+	TabletCellCount    int                `json:"tabletCellCount,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:printcolumn:name="Health",type="string",JSONPath=".status.health",description="Health of the bundle"
+//+kubebuilder:printcolumn:name="Cells",type="integer",JSONPath=".status.tabletCellCount",description="Number of tablet cells"
+//+kubebuilder:subresource:status
+
+// TabletCellBundle is the Schema for the tabletcellbundles API
+type TabletCellBundle struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TabletCellBundleSpec   `json:"spec,omitempty"`
+	Status TabletCellBundleStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// TabletCellBundleList contains a list of TabletCellBundle
+type TabletCellBundleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TabletCellBundle `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TabletCellBundle{}, &TabletCellBundleList{})
+}
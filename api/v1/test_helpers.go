@@ -25,7 +25,9 @@ func CreateBaseYtsaurusResource(namespace string) *Ytsaurus {
 			Namespace: namespace,
 		},
 		Spec: YtsaurusSpec{
-			CoreImage: CoreImageFirst,
+			CommonSpec: CommonSpec{
+				CoreImage: CoreImageFirst,
+			},
 			Discovery: DiscoverySpec{
 				InstanceSpec: InstanceSpec{
 					InstanceCount: 1,
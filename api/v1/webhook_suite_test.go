@@ -105,7 +105,7 @@ var _ = BeforeSuite(func() {
 	})
 	Expect(err).NotTo(HaveOccurred())
 
-	err = (&Ytsaurus{}).SetupWebhookWithManager(mgr)
+	err = (&Ytsaurus{}).SetupWebhookWithManager(mgr, false)
 	Expect(err).NotTo(HaveOccurred())
 
 	err = (&Spyt{}).SetupWebhookWithManager(mgr)
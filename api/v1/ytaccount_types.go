@@ -0,0 +1,91 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type AccountResources struct {
+	// Disk space by medium name, media must be declared in data node locations.
+	//+optional
+	DiskSpacePerMedium map[string]resource.Quantity `json:"diskSpacePerMedium,omitempty"`
+	//+kubebuilder:validation:Minimum=0
+	//+optional
+	NodeCount *int64 `json:"nodeCount,omitempty"`
+	//+kubebuilder:validation:Minimum=0
+	//+optional
+	ChunkCount *int64 `json:"chunkCount,omitempty"`
+	//+kubebuilder:validation:Minimum=0
+	//+optional
+	TabletCount *int64 `json:"tabletCount,omitempty"`
+	//+optional
+	TabletStaticMemory *resource.Quantity `json:"tabletStaticMemory,omitempty"`
+}
+
+// YtAccountSpec defines the desired state of YtAccount
+type YtAccountSpec struct {
+	Ytsaurus corev1.LocalObjectReference `json:"ytsaurus"`
+
+	// Name of the account, the name of the resource is used by default.
+	//+optional
+	AccountName string `json:"accountName,omitempty"`
+	// Name of the parent account, the account is created in the root of the hierarchy by default.
+	//+optional
+	ParentAccount string `json:"parentAccount,omitempty"`
+
+	// Limits which are not set are left as is.
+	//+optional
+	ResourceLimits AccountResources `json:"resourceLimits,omitempty"`
+}
+
+// YtAccountStatus defines the observed state of YtAccount
+type YtAccountStatus struct {
+	Conditions         []metav1.Condition `json:"conditions,omitempty"`
+	ObservedGeneration int64              `json:"observedGeneration,omitempty"`
+	ResourceUsage      AccountResources   `json:"resourceUsage,omitempty"`
+	ResourceLimits     AccountResources   `json:"resourceLimits,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:printcolumn:name="Parent",type="string",JSONPath=".spec.parentAccount",description="Parent account"
+//+kubebuilder:printcolumn:name="NodeUsage",type="integer",JSONPath=".status.resourceUsage.nodeCount",description="Node count usage"
+//+kubebuilder:printcolumn:name="NodeLimit",type="integer",JSONPath=".status.resourceLimits.nodeCount",description="Node count limit"
+//+kubebuilder:subresource:status
+
+// YtAccount is the Schema for the ytaccounts API
+type YtAccount struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   YtAccountSpec   `json:"spec,omitempty"`
+	Status YtAccountStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// YtAccountList contains a list of YtAccount
+type YtAccountList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []YtAccount `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&YtAccount{}, &YtAccountList{})
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// YtGroupSpec defines the desired state of YtGroup
+type YtGroupSpec struct {
+	Ytsaurus corev1.LocalObjectReference `json:"ytsaurus"`
+
+	// Name of the group, the name of the resource is used by default.
+	//+optional
+	GroupName string `json:"groupName,omitempty"`
+
+	// Users and groups which are members of the group, the operator removes all other members.
+	//+optional
+	Members []string `json:"members,omitempty"`
+
+	// Access control entries of the group, the operator owns all entries with the only subject being this group on these paths.
+	//+optional
+	ACL []ACESpec `json:"acl,omitempty"`
+}
+
+// YtGroupStatus defines the observed state of YtGroup
+type YtGroupStatus struct {
+	Conditions         []metav1.Condition `json:"conditions,omitempty"`
+	ObservedGeneration int64              `json:"observedGeneration,omitempty"`
+	// Paths with access control entries managed by the operator.
+	ACLPaths []string `json:"aclPaths,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// YtGroup is the Schema for the ytgroups API
+type YtGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   YtGroupSpec   `json:"spec,omitempty"`
+	Status YtGroupStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// YtGroupList contains a list of YtGroup
+type YtGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []YtGroup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&YtGroup{}, &YtGroupList{})
+}
@@ -210,6 +210,55 @@ type OauthServiceSpec struct {
 	UserInfo OauthUserInfoHandlerSpec `json:"userInfoHandler,omitempty"`
 }
 
+type OidcSpec struct {
+	// URL of the OpenID Connect issuer, the endpoints are discovered from it.
+	//+kubebuilder:validation:Pattern:=`^https?://`
+	Issuer   string `json:"issuer"`
+	ClientID string `json:"clientId"`
+	// Key of the secret with the client secret.
+	ClientSecret corev1.SecretKeySelector `json:"clientSecret"`
+	//+kubebuilder:default:={"openid","profile","email"}
+	Scopes []string `json:"scopes,omitempty"`
+	// Claim with the login of the user.
+	//+kubebuilder:default:=preferred_username
+	LoginClaim string `json:"loginClaim,omitempty"`
+	// Claim with the groups of the user, groups are not synced if it is not set.
+	//+optional
+	GroupsClaim string `json:"groupsClaim,omitempty"`
+	// Groups of the identity provider mapped to YT groups, other groups are ignored.
+	//+optional
+	GroupMapping map[string]string `json:"groupMapping,omitempty"`
+}
+
+type LdapSpec struct {
+	//+kubebuilder:validation:MinLength:=1
+	Host string `json:"host"`
+	//+kubebuilder:default:=389
+	Port int `json:"port,omitempty"`
+	//+kubebuilder:default:=false
+	Secure bool `json:"secure,omitempty"`
+	// Distinguished name to bind with when users are searched.
+	BindDN string `json:"bindDn"`
+	// Key of the secret with the password of the bind DN.
+	BindPassword   corev1.SecretKeySelector `json:"bindPassword"`
+	UserSearchBase string                   `json:"userSearchBase"`
+	// Filter of the user entry, %s is replaced with the login.
+	//+kubebuilder:default:="(uid=%s)"
+	UserFilter string `json:"userFilter,omitempty"`
+	// Groups of the user are searched under the base, groups are not synced if it is not set.
+	//+optional
+	GroupSearchBase string `json:"groupSearchBase,omitempty"`
+	// LDAP groups mapped to YT groups, other groups are ignored.
+	//+optional
+	GroupMapping map[string]string `json:"groupMapping,omitempty"`
+}
+
+// AuthenticationSpec configures external authentication of users in HTTP proxies and the UI.
+type AuthenticationSpec struct {
+	Oidc *OidcSpec `json:"oidc,omitempty"`
+	Ldap *LdapSpec `json:"ldap,omitempty"`
+}
+
 type InstanceSpec struct {
 	Image                *string                         `json:"image,omitempty"`
 	Volumes              []corev1.Volume                 `json:"volumes,omitempty"`
@@ -230,12 +279,49 @@ type InstanceSpec struct {
 type MastersSpec struct {
 	InstanceSpec `json:",inline"`
 	CellTag      int16 `json:"cellTag"`
+
+	// HostAddresses are the addresses of the nodes which run masters when host network is enabled,
+	// masters are not run in host network without them.
+	// They are used as Hydra peer addresses, so there must be one per master instance,
+	// and each of them must be equal to the FQDN of the node as reported by the master on it,
+	// otherwise the master doesn't find itself among the peers and the quorum is not formed.
+	//+optional
+	HostAddresses []string `json:"hostAddresses,omitempty"`
+	// HostAddressLabel is the node label matched against HostAddresses to place masters.
+	// Defaults to kubernetes.io/hostname.
+	//+optional
+	HostAddressLabel string `json:"hostAddressLabel,omitempty"`
+}
+
+// CertificateIssuerReference refers to a cert-manager issuer.
+type CertificateIssuerReference struct {
+	//+kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+	//+kubebuilder:default:=Issuer
+	//+kubebuilder:validation:Enum=Issuer;ClusterIssuer
+	//+optional
+	Kind string `json:"kind,omitempty"`
+	//+kubebuilder:default:=cert-manager.io
+	//+optional
+	Group string `json:"group,omitempty"`
+}
+
+// CertificateSpec makes the operator request a cert-manager Certificate.
+// DNS names of the services created by the operator are included automatically.
+type CertificateSpec struct {
+	IssuerRef CertificateIssuerReference `json:"issuerRef"`
+	// Additional DNS names, e.g. external hostnames.
+	//+optional
+	ExtraDNSNames []string `json:"extraDnsNames,omitempty"`
 }
 
 type HTTPTransportSpec struct {
 	// Reference to kubernetes.io/tls secret
 	//+optional
 	HTTPSSecret *corev1.LocalObjectReference `json:"httpsSecret,omitempty"`
+	// Issue the certificate into httpsSecret by cert-manager.
+	//+optional
+	HTTPSCertificate *CertificateSpec `json:"httpsCertificate,omitempty"`
 	//+kubebuilder:default:=false
 	//+optional
 	DisableHTTP bool `json:"disableHttp,omitempty"`
@@ -250,12 +336,59 @@ type HTTPProxiesSpec struct {
 	Role string `json:"role,omitempty"`
 	//+optional
 	Transport HTTPTransportSpec `json:"transport,omitempty"`
+	// Exposes the proxies outside of the Kubernetes cluster by a hostname.
+	//+optional
+	ExternalAccess *ExternalAccessSpec `json:"externalAccess,omitempty"`
+}
+
+// ExternalAccessSpec describes how an HTTP endpoint is exposed outside of the Kubernetes cluster.
+// Exactly one of ingress and httpRoute must be set.
+type ExternalAccessSpec struct {
+	// Hostname the external clients use, it is also advertised to them by the cluster.
+	//+kubebuilder:validation:MinLength:=1
+	Hostname string `json:"hostname"`
+	// Generate networking.k8s.io/v1 Ingress.
+	//+optional
+	Ingress *IngressSpec `json:"ingress,omitempty"`
+	// Generate gateway.networking.k8s.io/v1 HTTPRoute.
+	//+optional
+	HTTPRoute *HTTPRouteSpec `json:"httpRoute,omitempty"`
+}
+
+type IngressSpec struct {
+	//+optional
+	IngressClassName *string `json:"ingressClassName,omitempty"`
+	// Reference to kubernetes.io/tls secret used by the ingress controller to terminate TLS for the hostname.
+	//+optional
+	TLSSecret *corev1.LocalObjectReference `json:"tlsSecret,omitempty"`
+	//+optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type HTTPRouteSpec struct {
+	// Gateways the route is attached to. TLS is configured on the gateway listeners.
+	//+kubebuilder:validation:MinItems:=1
+	ParentRefs []GatewayReference `json:"parentRefs"`
+}
+
+type GatewayReference struct {
+	//+kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+	// Defaults to the namespace of the route.
+	//+optional
+	Namespace *string `json:"namespace,omitempty"`
+	// Name of the gateway listener.
+	//+optional
+	SectionName *string `json:"sectionName,omitempty"`
 }
 
 type RPCTransportSpec struct {
 	// Reference to kubernetes.io/tls secret
 	//+optional
 	TLSSecret *corev1.LocalObjectReference `json:"tlsSecret,omitempty"`
+	// Issue the certificate into tlsSecret by cert-manager.
+	//+optional
+	TLSCertificate *CertificateSpec `json:"tlsCertificate,omitempty"`
 	// Require encrypted connections, otherwise only when required by peer
 	//+optional
 	TLSRequired bool `json:"tlsRequired,omitempty"`
@@ -338,6 +471,14 @@ type DiscoverySpec struct {
 	InstanceSpec `json:",inline"`
 }
 
+type MasterCachesSpec struct {
+	InstanceSpec `json:",inline"`
+}
+
+type TimestampProvidersSpec struct {
+	InstanceSpec `json:",inline"`
+}
+
 type UISpec struct {
 	Image *string `json:"image,omitempty"`
 	//+kubebuilder:default:=NodePort
@@ -359,12 +500,32 @@ type UISpec struct {
 	Theme       string  `json:"theme,omitempty"`
 	Description *string `json:"description,omitempty"`
 	Group       *string `json:"group,omitempty"`
+
+	// Exposes the UI outside of the Kubernetes cluster by a hostname.
+	//+optional
+	ExternalAccess *ExternalAccessSpec `json:"externalAccess,omitempty"`
 }
 
 type QueryTrackerSpec struct {
 	InstanceSpec `json:",inline"`
 }
 
+type QueueAgentSpec struct {
+	InstanceSpec `json:",inline"`
+}
+
+type TabletBalancersSpec struct {
+	InstanceSpec `json:",inline"`
+}
+
+type CellBalancersSpec struct {
+	InstanceSpec `json:",inline"`
+}
+
+type ReplicatedTableTrackerSpec struct {
+	InstanceSpec `json:",inline"`
+}
+
 type StrawberryControllerSpec struct {
 	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
 	Image     *string                     `json:"image,omitempty"`
@@ -374,32 +535,46 @@ type YQLAgentSpec struct {
 	InstanceSpec `json:",inline"`
 }
 
+type RemoteYtsaurusReference struct {
+	Name string `json:"name"`
+	// Namespace defaults to the namespace of the referring Ytsaurus.
+	//+optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// RemoteClusterSpec describes a cluster to be registered in //sys/clusters.
+// Exactly one of Ytsaurus and ClusterConnection must be set.
+type RemoteClusterSpec struct {
+	//+kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+	//+optional
+	Ytsaurus *RemoteYtsaurusReference `json:"ytsaurus,omitempty"`
+	// ClusterConnection is a raw cluster connection in YSON.
+	//+optional
+	ClusterConnection *string `json:"clusterConnection,omitempty"`
+}
+
 type DeprecatedSpytSpec struct {
 	SparkVersion string `json:"sparkVersion,omitempty"`
 	SpytVersion  string `json:"spytVersion,omitempty"`
 }
 
-// YtsaurusSpec defines the desired state of Ytsaurus
-type YtsaurusSpec struct {
+// CommonSpec contains the fields shared by Ytsaurus and the resources
+// which run YTsaurus components outside of it, e.g. remote nodes.
+type CommonSpec struct {
 	CoreImage string `json:"coreImage,omitempty"`
-	UIImage   string `json:"uiImage,omitempty"`
 
 	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
 	ConfigOverrides  *corev1.LocalObjectReference  `json:"configOverrides,omitempty"`
-	AdminCredentials *corev1.LocalObjectReference  `json:"adminCredentials,omitempty"`
-
-	OauthService *OauthServiceSpec `json:"oauthService,omitempty"`
-
-	//+kubebuilder:default:=true
-	//+optional
-	IsManaged bool `json:"isManaged"`
-	//+kubebuilder:default:=true
-	//+optional
-	EnableFullUpdate bool `json:"enableFullUpdate"`
 
 	//+kubebuilder:default:=false
 	//+optional
 	UseIPv6 bool `json:"useIpv6"`
+	// IPFamilyPolicy of the services created by the operator. In the dual-stack mode
+	// both IP families are enabled and the primary one is chosen by useIpv6.
+	//+kubebuilder:validation:Enum=SingleStack;PreferDualStack;RequireDualStack
+	//+optional
+	IPFamilyPolicy *corev1.IPFamilyPolicyType `json:"ipFamilyPolicy,omitempty"`
 	//+kubebuilder:default:=true
 	//+optional
 	UseShortNames bool `json:"useShortNames"`
@@ -411,12 +586,36 @@ type YtsaurusSpec struct {
 	HostNetwork bool `json:"hostNetwork"`
 
 	ExtraPodAnnotations map[string]string `json:"extraPodAnnotations,omitempty"`
+}
+
+// YtsaurusSpec defines the desired state of Ytsaurus
+type YtsaurusSpec struct {
+	CommonSpec `json:",inline"`
+
+	UIImage string `json:"uiImage,omitempty"`
+
+	// AdminCredentials refers to a secret with login, password and token of the admin user.
+	// Random password and token are generated if it is not set.
+	AdminCredentials *corev1.LocalObjectReference `json:"adminCredentials,omitempty"`
+
+	OauthService   *OauthServiceSpec   `json:"oauthService,omitempty"`
+	Authentication *AuthenticationSpec `json:"authentication,omitempty"`
+
+	//+kubebuilder:default:=true
+	//+optional
+	IsManaged bool `json:"isManaged"`
+	//+kubebuilder:default:=true
+	//+optional
+	EnableFullUpdate bool `json:"enableFullUpdate"`
 
 	Bootstrap *BootstrapSpec `json:"bootstrap,omitempty"`
 
-	Discovery        DiscoverySpec `json:"discovery,omitempty"`
-	PrimaryMasters   MastersSpec   `json:"primaryMasters,omitempty"`
-	SecondaryMasters []MastersSpec `json:"secondaryMasters,omitempty"`
+	Discovery        DiscoverySpec     `json:"discovery,omitempty"`
+	PrimaryMasters   MastersSpec       `json:"primaryMasters,omitempty"`
+	SecondaryMasters []MastersSpec     `json:"secondaryMasters,omitempty"`
+	MasterCaches     *MasterCachesSpec `json:"masterCaches,omitempty"`
+	// TimestampProviders are optional, masters serve timestamps when they are not deployed.
+	TimestampProviders *TimestampProvidersSpec `json:"timestampProviders,omitempty"`
 	// +kubebuilder:validation:MinItems:=1
 	HTTPProxies []HTTPProxiesSpec `json:"httpProxies,omitempty"`
 	RPCProxies  []RPCProxiesSpec  `json:"rpcProxies,omitempty"`
@@ -427,14 +626,90 @@ type YtsaurusSpec struct {
 	Schedulers       *SchedulersSpec       `json:"schedulers,omitempty"`
 	ControllerAgents *ControllerAgentsSpec `json:"controllerAgents,omitempty"`
 	TabletNodes      []TabletNodesSpec     `json:"tabletNodes,omitempty"`
+	TabletBalancers  *TabletBalancersSpec  `json:"tabletBalancers,omitempty"`
+	CellBalancers    *CellBalancersSpec    `json:"cellBalancers,omitempty"`
+	// ReplicatedTableTrackers replace the tracker embedded into masters.
+	ReplicatedTableTrackers *ReplicatedTableTrackerSpec `json:"replicatedTableTrackers,omitempty"`
 
 	StrawberryController     *StrawberryControllerSpec `json:"strawberry,omitempty"`
 	DeprecatedChytController *StrawberryControllerSpec `json:"chyt,omitempty"`
 	QueryTrackers            *QueryTrackerSpec         `json:"queryTrackers,omitempty"`
+	QueueAgents              *QueueAgentSpec           `json:"queueAgents,omitempty"`
 	Spyt                     *DeprecatedSpytSpec       `json:"spyt,omitempty"`
 	YQLAgents                *YQLAgentSpec             `json:"yqlAgents,omitempty"`
 
 	UI *UISpec `json:"ui,omitempty"`
+
+	RemoteClusters []RemoteClusterSpec `json:"remoteClusters,omitempty"`
+
+	// Encryption of the RPC traffic between cluster components.
+	//+optional
+	InternalTransport *InternalTransportSpec `json:"internalTransport,omitempty"`
+
+	// NetworkPolicy makes the operator restrict the ingress traffic of the cluster pods.
+	//+optional
+	NetworkPolicy *NetworkPolicySpec `json:"networkPolicy,omitempty"`
+
+	// RobotTokenRotation makes the operator rotate tokens of the robot users it manages.
+	//+optional
+	RobotTokenRotation *RobotTokenRotationSpec `json:"robotTokenRotation,omitempty"`
+}
+
+// RobotTokenRotationSpec configures the rotation of the robot tokens issued by the operator.
+// The new token is registered before the secret is updated and the pods using it are rolled,
+// the previous token is revoked after the grace period.
+type RobotTokenRotationSpec struct {
+	// Period is the maximum age of a token, tokens are not rotated on schedule if it is not set.
+	//+optional
+	Period *metav1.Duration `json:"period,omitempty"`
+	// Tokens issued before RotateBefore are rotated, set it to the current time to rotate them on demand.
+	//+optional
+	RotateBefore *metav1.Time `json:"rotateBefore,omitempty"`
+	// GracePeriod is the time during which the previous token remains valid.
+	//+kubebuilder:default:="1h"
+	//+optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
+// NetworkPolicySpec configures the NetworkPolicies created for each component.
+// Pods of the cluster and of its jobs may connect to any component,
+// proxies and UI accept connections from everywhere on their public ports.
+// Pods of remote nodes attached to the cluster may connect to any component as well.
+// The NetworkPolicies are removed when the spec is unset.
+type NetworkPolicySpec struct {
+	// MonitoringNamespaceSelector selects the namespaces which may scrape the monitoring ports.
+	// Monitoring ports are not opened if it is not set.
+	//+optional
+	MonitoringNamespaceSelector *metav1.LabelSelector `json:"monitoringNamespaceSelector,omitempty"`
+	// RemoteNodesNamespaceSelector selects the namespaces of remote nodes attached to the cluster.
+	// Only remote nodes from the namespace of the cluster are allowed if it is not set.
+	//+optional
+	RemoteNodesNamespaceSelector *metav1.LabelSelector `json:"remoteNodesNamespaceSelector,omitempty"`
+}
+
+type BusEncryptionMode string
+
+const (
+	BusEncryptionModeDisabled BusEncryptionMode = "disabled"
+	BusEncryptionModeOptional BusEncryptionMode = "optional"
+	BusEncryptionModeRequired BusEncryptionMode = "required"
+)
+
+// InternalTransportSpec configures TLS for the bus connections between cluster components.
+// Every component gets its own certificate from cert-manager, stored in the secret
+// named after its stateful set with the `-bus-tls` suffix.
+type InternalTransportSpec struct {
+	// Target encryption mode. Switching between disabled and required always goes
+	// through optional, so components with old and new configs can talk to each other.
+	//+kubebuilder:default:=optional
+	//+kubebuilder:validation:Enum=disabled;optional;required
+	//+optional
+	EncryptionMode BusEncryptionMode `json:"encryptionMode,omitempty"`
+	// Issuer of the component certificates.
+	IssuerRef CertificateIssuerReference `json:"issuerRef"`
+	// Secret with ca.crt to verify the certificates of peers. If not set, peers are not verified.
+	//+optional
+	CASecret *corev1.LocalObjectReference `json:"caSecret,omitempty"`
 }
 
 type ClusterState string
@@ -467,6 +742,8 @@ const (
 	UpdateStateWaitingForOpArchiveUpdate          UpdateState = "WaitingForOpArchiveUpdate"
 	UpdateStateWaitingForQTStateUpdatingPrepare   UpdateState = "WaitingForQTStateUpdatingPrepare"
 	UpdateStateWaitingForQTStateUpdate            UpdateState = "WaitingForQTStateUpdate"
+	UpdateStateWaitingForQAStateUpdatingPrepare   UpdateState = "WaitingForQAStateUpdatingPrepare"
+	UpdateStateWaitingForQAStateUpdate            UpdateState = "WaitingForQAStateUpdate"
 	UpdateStateWaitingForSafeModeDisabled         UpdateState = "WaitingForSafeModeDisabled"
 )
 
@@ -491,6 +768,19 @@ type YtsaurusStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 
 	UpdateStatus UpdateStatus `json:"updateStatus,omitempty"`
+
+	// RemoteClusters are the names registered in //sys/clusters by the operator.
+	RemoteClusters []string `json:"remoteClusters,omitempty"`
+
+	// InternalTransportEncryptionMode is the bus encryption mode in the component configs.
+	// It follows spec.internalTransport.encryptionMode one step at a time.
+	//+optional
+	InternalTransportEncryptionMode BusEncryptionMode `json:"internalTransportEncryptionMode,omitempty"`
+
+	// AdminCredentialsSecret is the secret with the admin credentials generated by the operator
+	// when spec.adminCredentials is not set.
+	//+optional
+	AdminCredentialsSecret string `json:"adminCredentialsSecret,omitempty"`
 }
 
 //+kubebuilder:rbac:groups=cluster.ytsaurus.tech,resources=ytsaurus,verbs=get;list;watch;create;update;patch;delete
@@ -505,6 +795,10 @@ type YtsaurusStatus struct {
 //+kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;update;patch;delete
 
 // +kubebuilder:object:root=true
 // +kubebuilder:printcolumn:name="ClusterState",type="string",JSONPath=".status.state",description="State of Ytsaurus cluster"
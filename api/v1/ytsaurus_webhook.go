@@ -17,10 +17,14 @@ limitations under the License.
 package v1
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"go.ytsaurus.tech/yt/go/yson"
+	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,12 +35,21 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
 // log is for logging in this package.
 var ytsauruslog = logf.Log.WithName("ytsaurus-resource")
 
-func (r *Ytsaurus) SetupWebhookWithManager(mgr ctrl.Manager) error {
+const validateYtsaurusPath = "/validate-cluster-ytsaurus-tech-v1-ytsaurus"
+
+// SetupWebhookWithManager registers the webhooks of Ytsaurus. If requireAdminCredentials is set,
+// clusters without spec.adminCredentials are rejected, otherwise they are admitted with a warning.
+func (r *Ytsaurus) SetupWebhookWithManager(mgr ctrl.Manager, requireAdminCredentials bool) error {
+	// The validating webhook is registered in advance, so the builder registers only the defaulting one.
+	mgr.GetWebhookServer().Register(validateYtsaurusPath, &webhook.Admission{
+		Handler: &ytsaurusValidator{requireAdminCredentials: requireAdminCredentials},
+	})
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
 		Complete()
@@ -75,7 +88,46 @@ func (r *Ytsaurus) Default() {
 // TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
 //+kubebuilder:webhook:path=/validate-cluster-ytsaurus-tech-v1-ytsaurus,mutating=false,failurePolicy=fail,sideEffects=None,groups=cluster.ytsaurus.tech,resources=ytsaurus,verbs=create;update,versions=v1,name=vytsaurus.kb.io,admissionReviewVersions=v1
 
-var _ webhook.Validator = &Ytsaurus{}
+// ytsaurusValidator is an admission handler rather than webhook.Validator,
+// since the latter can't return warnings.
+type ytsaurusValidator struct {
+	requireAdminCredentials bool
+	decoder                 *admission.Decoder
+}
+
+var _ admission.Handler = &ytsaurusValidator{}
+var _ admission.DecoderInjector = &ytsaurusValidator{}
+
+func (r *Ytsaurus) validateRemoteClusters(old *runtime.Object) field.ErrorList {
+	var allErrors field.ErrorList
+
+	names := make(map[string]bool)
+	for i, rc := range r.Spec.RemoteClusters {
+		path := field.NewPath("spec").Child("remoteClusters").Index(i)
+
+		if _, exists := names[rc.Name]; exists {
+			allErrors = append(allErrors, field.Duplicate(path.Child("name"), rc.Name))
+		}
+		names[rc.Name] = true
+
+		if rc.Name == r.Name {
+			allErrors = append(allErrors, field.Invalid(path.Child("name"), rc.Name, "the local cluster is registered automatically"))
+		}
+
+		if (rc.Ytsaurus == nil) == (rc.ClusterConnection == nil) {
+			allErrors = append(allErrors, field.Invalid(path, rc.Name, "exactly one of ytsaurus and clusterConnection must be set"))
+		}
+
+		if rc.ClusterConnection != nil {
+			var clusterConnection map[string]interface{}
+			if err := yson.Unmarshal([]byte(*rc.ClusterConnection), &clusterConnection); err != nil {
+				allErrors = append(allErrors, field.Invalid(path.Child("clusterConnection"), *rc.ClusterConnection, fmt.Sprintf("must be a YSON map: %s", err)))
+			}
+		}
+	}
+
+	return allErrors
+}
 
 //////////////////////////////////////////////////
 
@@ -124,6 +176,28 @@ func (r *Ytsaurus) validateSecondaryMasters(old *runtime.Object) field.ErrorList
 	return allErrors
 }
 
+func (r *Ytsaurus) validateMasterCaches(old *runtime.Object) field.ErrorList {
+	var allErrors field.ErrorList
+
+	if r.Spec.MasterCaches != nil {
+		path := field.NewPath("spec").Child("masterCaches")
+		allErrors = append(allErrors, r.validateInstanceSpec(r.Spec.MasterCaches.InstanceSpec, path)...)
+	}
+
+	return allErrors
+}
+
+func (r *Ytsaurus) validateTimestampProviders(old *runtime.Object) field.ErrorList {
+	var allErrors field.ErrorList
+
+	if r.Spec.TimestampProviders != nil {
+		path := field.NewPath("spec").Child("timestampProviders")
+		allErrors = append(allErrors, r.validateInstanceSpec(r.Spec.TimestampProviders.InstanceSpec, path)...)
+	}
+
+	return allErrors
+}
+
 func (r *Ytsaurus) validateHTTPProxies(old *runtime.Object) field.ErrorList {
 	var allErrors field.ErrorList
 
@@ -140,6 +214,11 @@ func (r *Ytsaurus) validateHTTPProxies(old *runtime.Object) field.ErrorList {
 		httpRoles[hp.Role] = true
 
 		allErrors = append(allErrors, r.validateInstanceSpec(hp.InstanceSpec, path)...)
+		allErrors = append(allErrors, r.validateExternalAccess(hp.ExternalAccess, path.Child("externalAccess"))...)
+
+		if hp.Transport.HTTPSCertificate != nil && hp.Transport.HTTPSSecret == nil {
+			allErrors = append(allErrors, field.Required(path.Child("transport").Child("httpsSecret"), "httpsSecret is required to store the certificate"))
+		}
 	}
 
 	if !hasDefaultHTTPProxy {
@@ -151,6 +230,31 @@ func (r *Ytsaurus) validateHTTPProxies(old *runtime.Object) field.ErrorList {
 	return allErrors
 }
 
+func (r *Ytsaurus) validateExternalAccess(spec *ExternalAccessSpec, path *field.Path) field.ErrorList {
+	var allErrors field.ErrorList
+
+	if spec == nil {
+		return allErrors
+	}
+
+	if (spec.Ingress == nil) == (spec.HTTPRoute == nil) {
+		allErrors = append(allErrors, field.Invalid(path, spec.Hostname, "exactly one of ingress and httpRoute must be set"))
+	}
+
+	return allErrors
+}
+
+func (r *Ytsaurus) validateUI(old *runtime.Object) field.ErrorList {
+	var allErrors field.ErrorList
+
+	if r.Spec.UI != nil {
+		path := field.NewPath("spec").Child("ui")
+		allErrors = append(allErrors, r.validateExternalAccess(r.Spec.UI.ExternalAccess, path.Child("externalAccess"))...)
+	}
+
+	return allErrors
+}
+
 func (r *Ytsaurus) validateRPCProxies(old *runtime.Object) field.ErrorList {
 	var allErrors field.ErrorList
 
@@ -163,6 +267,10 @@ func (r *Ytsaurus) validateRPCProxies(old *runtime.Object) field.ErrorList {
 		rpcRoles[rp.Role] = true
 
 		allErrors = append(allErrors, r.validateInstanceSpec(rp.InstanceSpec, path)...)
+
+		if rp.Transport.TLSCertificate != nil && rp.Transport.TLSSecret == nil {
+			allErrors = append(allErrors, field.Required(path.Child("transport").Child("tlsSecret"), "tlsSecret is required to store the certificate"))
+		}
 	}
 
 	return allErrors
@@ -313,6 +421,51 @@ func (r *Ytsaurus) validateTabletNodes(old *runtime.Object) field.ErrorList {
 	return allErrors
 }
 
+func (r *Ytsaurus) validateTabletBalancers(old *runtime.Object) field.ErrorList {
+	var allErrors field.ErrorList
+
+	if r.Spec.TabletBalancers != nil {
+		path := field.NewPath("spec").Child("tabletBalancers")
+		allErrors = append(allErrors, r.validateInstanceSpec(r.Spec.TabletBalancers.InstanceSpec, path)...)
+
+		if r.Spec.TabletNodes == nil || len(r.Spec.TabletNodes) == 0 {
+			allErrors = append(allErrors, field.Required(field.NewPath("spec").Child("tabletNodes"), "tabletNodes are required for tabletBalancers"))
+		}
+	}
+
+	return allErrors
+}
+
+func (r *Ytsaurus) validateCellBalancers(old *runtime.Object) field.ErrorList {
+	var allErrors field.ErrorList
+
+	if r.Spec.CellBalancers != nil {
+		path := field.NewPath("spec").Child("cellBalancers")
+		allErrors = append(allErrors, r.validateInstanceSpec(r.Spec.CellBalancers.InstanceSpec, path)...)
+
+		if r.Spec.TabletNodes == nil || len(r.Spec.TabletNodes) == 0 {
+			allErrors = append(allErrors, field.Required(field.NewPath("spec").Child("tabletNodes"), "tabletNodes are required for cellBalancers"))
+		}
+	}
+
+	return allErrors
+}
+
+func (r *Ytsaurus) validateReplicatedTableTrackers(old *runtime.Object) field.ErrorList {
+	var allErrors field.ErrorList
+
+	if r.Spec.ReplicatedTableTrackers != nil {
+		path := field.NewPath("spec").Child("replicatedTableTrackers")
+		allErrors = append(allErrors, r.validateInstanceSpec(r.Spec.ReplicatedTableTrackers.InstanceSpec, path)...)
+
+		if r.Spec.TabletNodes == nil || len(r.Spec.TabletNodes) == 0 {
+			allErrors = append(allErrors, field.Required(field.NewPath("spec").Child("tabletNodes"), "tabletNodes are required for replicatedTableTrackers"))
+		}
+	}
+
+	return allErrors
+}
+
 func (r *Ytsaurus) validateChyt(old *runtime.Object) field.ErrorList {
 	var allErrors field.ErrorList
 
@@ -338,6 +491,21 @@ func (r *Ytsaurus) validateQueryTrackers(old *runtime.Object) field.ErrorList {
 	return allErrors
 }
 
+func (r *Ytsaurus) validateQueueAgents(old *runtime.Object) field.ErrorList {
+	var allErrors field.ErrorList
+
+	if r.Spec.QueueAgents != nil {
+		path := field.NewPath("spec").Child("queueAgents")
+		allErrors = append(allErrors, r.validateInstanceSpec(r.Spec.QueueAgents.InstanceSpec, path)...)
+
+		if r.Spec.TabletNodes == nil || len(r.Spec.TabletNodes) == 0 {
+			allErrors = append(allErrors, field.Required(field.NewPath("spec").Child("tabletNodes"), "tabletNodes are required for queueAgents"))
+		}
+	}
+
+	return allErrors
+}
+
 func (r *Ytsaurus) validateSpyt(old *runtime.Object) field.ErrorList {
 	var allErrors field.ErrorList
 	path := field.NewPath("spec").Child("spyt")
@@ -364,6 +532,154 @@ func (r *Ytsaurus) validateYQLAgents(old *runtime.Object) field.ErrorList {
 	return allErrors
 }
 
+// validateAdminCredentials rejects clusters without admin credentials if they are required,
+// otherwise it warns that the credentials are generated by the operator.
+func (r *Ytsaurus) validateAdminCredentials(requireAdminCredentials bool) (field.ErrorList, []string) {
+	var allErrors field.ErrorList
+	var warnings []string
+
+	if r.Spec.AdminCredentials == nil {
+		path := field.NewPath("spec").Child("adminCredentials")
+		if requireAdminCredentials {
+			allErrors = append(allErrors, field.Required(path, "admin credentials are required by the operator configuration"))
+		} else {
+			warnings = append(warnings, fmt.Sprintf(
+				"%s is not set, random admin credentials are generated into the secret from status.adminCredentialsSecret", path))
+		}
+	}
+
+	return allErrors, warnings
+}
+
+func (r *Ytsaurus) validateRobotTokenRotation(old *runtime.Object) field.ErrorList {
+	var allErrors field.ErrorList
+
+	spec := r.Spec.RobotTokenRotation
+	if spec == nil {
+		return allErrors
+	}
+
+	path := field.NewPath("spec").Child("robotTokenRotation")
+	if spec.Period != nil && spec.Period.Duration <= 0 {
+		allErrors = append(allErrors, field.Invalid(path.Child("period"), spec.Period.Duration.String(), "period must be positive"))
+	}
+	if spec.GracePeriod != nil && spec.GracePeriod.Duration < 0 {
+		allErrors = append(allErrors, field.Invalid(path.Child("gracePeriod"), spec.GracePeriod.Duration.String(), "grace period must not be negative"))
+	}
+	if spec.Period != nil && spec.GracePeriod != nil && spec.GracePeriod.Duration >= spec.Period.Duration {
+		allErrors = append(allErrors, field.Invalid(path.Child("gracePeriod"), spec.GracePeriod.Duration.String(), "grace period must be shorter than the rotation period"))
+	}
+
+	return allErrors
+}
+
+func (r *Ytsaurus) validateHostNetwork(old *runtime.Object) field.ErrorList {
+	var allErrors field.ErrorList
+
+	// Masters are not run in host network without host addresses, as it was before they were supported.
+	if !r.Spec.HostNetwork || len(r.Spec.PrimaryMasters.HostAddresses) == 0 {
+		return allErrors
+	}
+
+	path := field.NewPath("spec").Child("primaryMasters").Child("hostAddresses")
+	if len(r.Spec.PrimaryMasters.HostAddresses) != int(r.Spec.PrimaryMasters.InstanceCount) {
+		allErrors = append(allErrors, field.Invalid(
+			path,
+			r.Spec.PrimaryMasters.HostAddresses,
+			"an address is required for each master"))
+	}
+	hostAddresses := make(map[string]bool)
+	for i, hostAddress := range r.Spec.PrimaryMasters.HostAddresses {
+		if hostAddresses[hostAddress] {
+			allErrors = append(allErrors, field.Duplicate(path.Index(i), hostAddress))
+		}
+		hostAddresses[hostAddress] = true
+	}
+
+	allErrors = append(allErrors, r.validateHostPorts()...)
+
+	return allErrors
+}
+
+// validateHostPorts checks that the components which may share a node don't listen on the same ports.
+func (r *Ytsaurus) validateHostPorts() field.ErrorList {
+	var allErrors field.ErrorList
+
+	path := field.NewPath("spec").Child("hostNetwork")
+	usedPorts := make(map[int32]string)
+	addPorts := func(component string, ports ...int32) {
+		for _, port := range ports {
+			if other, exists := usedPorts[port]; exists && other != component {
+				allErrors = append(allErrors, field.Invalid(
+					path,
+					r.Spec.HostNetwork,
+					fmt.Sprintf("port %d is used by both %s and %s", port, other, component)))
+				continue
+			}
+			usedPorts[port] = component
+		}
+	}
+
+	addPorts("discovery", consts.DiscoveryRPCPort, consts.DiscoveryMonitoringPort)
+	addPorts("primaryMasters", consts.MasterRPCPort, consts.MasterMonitoringPort)
+	if r.Spec.MasterCaches != nil {
+		addPorts("masterCaches", consts.MasterCacheRPCPort, consts.MasterCacheMonitoringPort)
+	}
+	if r.Spec.TimestampProviders != nil {
+		addPorts("timestampProviders", consts.TimestampProviderRPCPort, consts.TimestampProviderMonitoringPort)
+	}
+	for _, hp := range r.Spec.HTTPProxies {
+		addPorts("httpProxies", consts.HTTPProxyRPCPort, consts.HTTPProxyMonitoringPort, consts.HTTPProxyHTTPPort)
+		if hp.Transport.HTTPSSecret != nil {
+			addPorts("httpProxies", consts.HTTPProxyHTTPSPort)
+		}
+	}
+	if len(r.Spec.RPCProxies) != 0 {
+		addPorts("rpcProxies", consts.RPCProxyRPCPort, consts.RPCProxyMonitoringPort)
+	}
+	if len(r.Spec.TCPProxies) != 0 {
+		addPorts("tcpProxies", consts.TCPProxyMonitoringPort)
+		for port := int32(consts.TCPProxyMinTCPPort); port <= consts.TCPProxyMaxTCPPort; port++ {
+			addPorts("tcpProxies", port)
+		}
+	}
+	if len(r.Spec.DataNodes) != 0 {
+		addPorts("dataNodes", consts.DataNodeRPCPort, consts.DataNodeMonitoringPort, consts.DataNodeSkynetPort)
+	}
+	if len(r.Spec.ExecNodes) != 0 {
+		addPorts("execNodes", consts.ExecNodeRPCPort, consts.ExecNodeMonitoringPort, consts.ExecNodeSkynetPort)
+	}
+	if len(r.Spec.TabletNodes) != 0 {
+		addPorts("tabletNodes", consts.TabletNodeRPCPort, consts.TabletNodeMonitoringPort, consts.TabletNodeSkynetPort)
+	}
+	if r.Spec.Schedulers != nil {
+		addPorts("schedulers", consts.SchedulerRPCPort, consts.SchedulerMonitoringPort)
+	}
+	if r.Spec.ControllerAgents != nil {
+		addPorts("controllerAgents", consts.ControllerAgentRPCPort, consts.ControllerAgentMonitoringPort)
+	}
+	if r.Spec.TabletBalancers != nil {
+		addPorts("tabletBalancers", consts.TabletBalancerRPCPort, consts.TabletBalancerMonitoringPort)
+	}
+	if r.Spec.CellBalancers != nil {
+		addPorts("cellBalancers", consts.CellBalancerRPCPort, consts.CellBalancerMonitoringPort)
+	}
+	if r.Spec.ReplicatedTableTrackers != nil {
+		addPorts("replicatedTableTrackers", consts.ReplicatedTableTrackerRPCPort, consts.ReplicatedTableTrackerMonitoringPort)
+	}
+	if r.Spec.QueryTrackers != nil {
+		addPorts("queryTrackers", consts.QueryTrackerRPCPort, consts.QueryTrackerMonitoringPort)
+	}
+	if r.Spec.QueueAgents != nil {
+		addPorts("queueAgents", consts.QueueAgentRPCPort, consts.QueueAgentMonitoringPort)
+	}
+	if r.Spec.YQLAgents != nil {
+		addPorts("yqlAgents", consts.YQLAgentRPCPort, consts.YQLAgentMonitoringPort)
+	}
+
+	return allErrors
+}
+
 //////////////////////////////////////////////////
 
 func (r *Ytsaurus) validateInstanceSpec(instanceSpec InstanceSpec, path *field.Path) field.ErrorList {
@@ -406,6 +722,8 @@ func (r *Ytsaurus) validateYtsaurus(old *runtime.Object) field.ErrorList {
 	allErrors = append(allErrors, r.validateDiscovery(old)...)
 	allErrors = append(allErrors, r.validatePrimaryMasters(old)...)
 	allErrors = append(allErrors, r.validateSecondaryMasters(old)...)
+	allErrors = append(allErrors, r.validateMasterCaches(old)...)
+	allErrors = append(allErrors, r.validateTimestampProviders(old)...)
 	allErrors = append(allErrors, r.validateHTTPProxies(old)...)
 	allErrors = append(allErrors, r.validateRPCProxies(old)...)
 	allErrors = append(allErrors, r.validateTCPProxies(old)...)
@@ -414,44 +732,61 @@ func (r *Ytsaurus) validateYtsaurus(old *runtime.Object) field.ErrorList {
 	allErrors = append(allErrors, r.validateSchedulers(old)...)
 	allErrors = append(allErrors, r.validateControllerAgents(old)...)
 	allErrors = append(allErrors, r.validateTabletNodes(old)...)
+	allErrors = append(allErrors, r.validateTabletBalancers(old)...)
+	allErrors = append(allErrors, r.validateCellBalancers(old)...)
+	allErrors = append(allErrors, r.validateReplicatedTableTrackers(old)...)
 	allErrors = append(allErrors, r.validateChyt(old)...)
 	allErrors = append(allErrors, r.validateQueryTrackers(old)...)
+	allErrors = append(allErrors, r.validateQueueAgents(old)...)
 	allErrors = append(allErrors, r.validateSpyt(old)...)
 	allErrors = append(allErrors, r.validateYQLAgents(old)...)
+	allErrors = append(allErrors, r.validateRemoteClusters(old)...)
+	allErrors = append(allErrors, r.validateUI(old)...)
+	allErrors = append(allErrors, r.validateHostNetwork(old)...)
+	allErrors = append(allErrors, r.validateRobotTokenRotation(old)...)
 
 	return allErrors
 }
 
-func (r *Ytsaurus) evaluateYtsaurusValidation(old *runtime.Object) error {
-	allErrors := r.validateYtsaurus(old)
-	if len(allErrors) == 0 {
-		return nil
-	}
-
-	return apierrors.NewInvalid(
-		schema.GroupKind{Group: "cluster.ytsaurus.tech", Kind: "Ytsaurus"},
-		r.Name,
-		allErrors)
-}
-
-// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
-func (r *Ytsaurus) ValidateCreate() error {
-	ytsauruslog.Info("validate create", "name", r.Name)
-
-	return r.evaluateYtsaurusValidation(nil)
+// InjectDecoder implements admission.DecoderInjector.
+func (v *ytsaurusValidator) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+	return nil
 }
 
-// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
-func (r *Ytsaurus) ValidateUpdate(old runtime.Object) error {
-	ytsauruslog.Info("validate update", "name", r.Name)
+// Handle implements admission.Handler, the webhook is called on create and update.
+func (v *ytsaurusValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	r := &Ytsaurus{}
+	if err := v.decoder.Decode(req, r); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	ytsauruslog.Info("validate "+strings.ToLower(string(req.Operation)), "name", r.Name)
 
-	return r.evaluateYtsaurusValidation(&old)
-}
+	var old *runtime.Object
+	if req.Operation == admissionv1.Update {
+		oldYtsaurus := &Ytsaurus{}
+		if err := v.decoder.DecodeRaw(req.OldObject, oldYtsaurus); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		var oldObject runtime.Object = oldYtsaurus
+		old = &oldObject
+	}
 
-// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
-func (r *Ytsaurus) ValidateDelete() error {
-	ytsauruslog.Info("validate delete", "name", r.Name)
+	allErrors := r.validateYtsaurus(old)
+	adminCredentialsErrors, warnings := r.validateAdminCredentials(v.requireAdminCredentials)
+	allErrors = append(allErrors, adminCredentialsErrors...)
+	if len(allErrors) != 0 {
+		status := apierrors.NewInvalid(
+			schema.GroupKind{Group: "cluster.ytsaurus.tech", Kind: "Ytsaurus"},
+			r.Name,
+			allErrors).Status()
+		return admission.Response{
+			AdmissionResponse: admissionv1.AdmissionResponse{
+				Allowed: false,
+				Result:  &status,
+			},
+		}.WithWarnings(warnings...)
+	}
 
-	// TODO(user): fill in your validation logic upon object deletion.
-	return nil
+	return admission.Allowed("").WithWarnings(warnings...)
 }
@@ -1,12 +1,21 @@
 package v1
 
 import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	admissionv1 "k8s.io/api/admission/v1"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
 var _ = Describe("Test for Ytsaurus webhooks", func() {
@@ -297,5 +306,108 @@ var _ = Describe("Test for Ytsaurus webhooks", func() {
 
 			Expect(k8sClient.Create(ctx, ytsaurus)).Should(MatchError(ContainSubstring("location path is not in any volume mount")))
 		})
+
+		It("Check remote clusters", func() {
+			ytsaurus := CreateBaseYtsaurusResource(namespace)
+			ytsaurus.Spec.RemoteClusters = []RemoteClusterSpec{{Name: "remote"}}
+
+			Expect(k8sClient.Create(ctx, ytsaurus)).Should(MatchError(ContainSubstring("exactly one of ytsaurus and clusterConnection must be set")))
+
+			ytsaurus = CreateBaseYtsaurusResource(namespace)
+			connection := "{cluster_name=remote"
+			ytsaurus.Spec.RemoteClusters = []RemoteClusterSpec{{Name: "remote", ClusterConnection: &connection}}
+
+			Expect(k8sClient.Create(ctx, ytsaurus)).Should(MatchError(ContainSubstring("spec.remoteClusters[0].clusterConnection")))
+		})
+
+		It("Check external access", func() {
+			ytsaurus := CreateBaseYtsaurusResource(namespace)
+			ytsaurus.Spec.HTTPProxies[0].ExternalAccess = &ExternalAccessSpec{Hostname: "yt.example.com"}
+
+			Expect(k8sClient.Create(ctx, ytsaurus)).Should(MatchError(ContainSubstring("exactly one of ingress and httpRoute must be set")))
+
+			ytsaurus = CreateBaseYtsaurusResource(namespace)
+			ytsaurus.Spec.UI = &UISpec{ExternalAccess: &ExternalAccessSpec{
+				Hostname:  "ui.example.com",
+				Ingress:   &IngressSpec{},
+				HTTPRoute: &HTTPRouteSpec{ParentRefs: []GatewayReference{{Name: "gateway"}}},
+			}}
+
+			Expect(k8sClient.Create(ctx, ytsaurus)).Should(MatchError(ContainSubstring("spec.ui.externalAccess")))
+		})
+
+		It("Check certificates", func() {
+			ytsaurus := CreateBaseYtsaurusResource(namespace)
+			ytsaurus.Spec.HTTPProxies[0].Transport.HTTPSCertificate = &CertificateSpec{
+				IssuerRef: CertificateIssuerReference{Name: "issuer"},
+			}
+
+			Expect(k8sClient.Create(ctx, ytsaurus)).Should(MatchError(ContainSubstring("spec.httpProxies[0].transport.httpsSecret")))
+		})
+
+		It("Check host network", func() {
+			ytsaurus := CreateBaseYtsaurusResource(namespace)
+			ytsaurus.Spec.HostNetwork = true
+			ytsaurus.Spec.PrimaryMasters.HostAddresses = []string{"host-1", "host-1"}
+
+			Expect(k8sClient.Create(ctx, ytsaurus)).Should(MatchError(ContainSubstring("spec.primaryMasters.hostAddresses")))
+		})
+
+		It("Check robot token rotation", func() {
+			ytsaurus := CreateBaseYtsaurusResource(namespace)
+			ytsaurus.Spec.RobotTokenRotation = &RobotTokenRotationSpec{
+				Period:      &metav1.Duration{Duration: time.Hour},
+				GracePeriod: &metav1.Duration{Duration: 2 * time.Hour},
+			}
+
+			Expect(k8sClient.Create(ctx, ytsaurus)).Should(MatchError(ContainSubstring("spec.robotTokenRotation.gracePeriod")))
+		})
 	})
 })
+
+// TestYtsaurusAdminCredentialsValidation calls the handler directly, so it doesn't need the test environment.
+func TestYtsaurusAdminCredentialsValidation(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(AddToScheme(scheme)).To(Succeed())
+	decoder, err := admission.NewDecoder(scheme)
+	g.Expect(err).To(Succeed())
+
+	validate := func(ytsaurus *Ytsaurus, requireAdminCredentials bool) admission.Response {
+		ytsaurus.SetGroupVersionKind(GroupVersion.WithKind("Ytsaurus"))
+		raw, err := json.Marshal(ytsaurus)
+		g.Expect(err).To(Succeed())
+
+		validator := &ytsaurusValidator{requireAdminCredentials: requireAdminCredentials}
+		g.Expect(validator.InjectDecoder(decoder)).To(Succeed())
+		return validator.Handle(context.Background(), admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				Object:    runtime.RawExtension{Raw: raw},
+			},
+		})
+	}
+
+	ytsaurus := CreateBaseYtsaurusResource("default")
+	// The default role is set by the CRD defaults, which are not applied here.
+	ytsaurus.Spec.HTTPProxies = []HTTPProxiesSpec{
+		{
+			Role:         consts.DefaultHTTPProxyRole,
+			InstanceSpec: InstanceSpec{InstanceCount: 1},
+		},
+	}
+
+	response := validate(ytsaurus, false)
+	g.Expect(response.Allowed).Should(BeTrue())
+	g.Expect(response.Warnings).Should(ConsistOf(ContainSubstring("spec.adminCredentials is not set")))
+
+	response = validate(ytsaurus, true)
+	g.Expect(response.Allowed).Should(BeFalse())
+	g.Expect(response.Result.Message).Should(ContainSubstring("spec.adminCredentials: Required value"))
+
+	ytsaurus.Spec.AdminCredentials = &v1.LocalObjectReference{Name: "admin-credentials"}
+	response = validate(ytsaurus, true)
+	g.Expect(response.Allowed).Should(BeTrue())
+	g.Expect(response.Warnings).Should(BeEmpty())
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ACESpec is an access control entry of the user or group on a Cypress path.
+type ACESpec struct {
+	Path string `json:"path"`
+	//+kubebuilder:validation:Enum={"allow","deny"}
+	//+kubebuilder:default:=allow
+	Action string `json:"action,omitempty"`
+	//+kubebuilder:validation:MinItems=1
+	Permissions []string `json:"permissions"`
+	//+kubebuilder:validation:Enum={"object_and_descendants","object_only","descendants_only","immediate_descendants_only"}
+	//+kubebuilder:default:=object_and_descendants
+	InheritanceMode string `json:"inheritanceMode,omitempty"`
+}
+
+// YtUserSpec defines the desired state of YtUser
+type YtUserSpec struct {
+	Ytsaurus corev1.LocalObjectReference `json:"ytsaurus"`
+
+	// Name of the user, the name of the resource is used by default.
+	//+optional
+	UserName string `json:"userName,omitempty"`
+
+	// Generate a token for the user and store it into a secret with YT_TOKEN key.
+	//+kubebuilder:default:=false
+	GenerateToken bool `json:"generateToken"`
+	// Name of the secret with the token, it is generated from the resource name by default.
+	//+optional
+	TokenSecretName string `json:"tokenSecretName,omitempty"`
+
+	// Access control entries of the user, the operator owns all entries with the only subject being this user on these paths.
+	//+optional
+	ACL []ACESpec `json:"acl,omitempty"`
+}
+
+// YtUserStatus defines the observed state of YtUser
+type YtUserStatus struct {
+	Conditions         []metav1.Condition `json:"conditions,omitempty"`
+	ObservedGeneration int64              `json:"observedGeneration,omitempty"`
+	TokenSecretName    string             `json:"tokenSecretName,omitempty"`
+	// Paths with access control entries managed by the operator.
+	ACLPaths []string `json:"aclPaths,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:printcolumn:name="TokenSecret",type="string",JSONPath=".status.tokenSecretName",description="Secret with the token of the user"
+//+kubebuilder:subresource:status
+
+// YtUser is the Schema for the ytusers API
+type YtUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   YtUserSpec   `json:"spec,omitempty"`
+	Status YtUserStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// YtUserList contains a list of YtUser
+type YtUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []YtUser `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&YtUser{}, &YtUserList{})
+}
@@ -23,10 +23,98 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ACESpec) DeepCopyInto(out *ACESpec) {
+	*out = *in
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACESpec.
+func (in *ACESpec) DeepCopy() *ACESpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ACESpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountResources) DeepCopyInto(out *AccountResources) {
+	*out = *in
+	if in.DiskSpacePerMedium != nil {
+		in, out := &in.DiskSpacePerMedium, &out.DiskSpacePerMedium
+		*out = make(map[string]resource.Quantity, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.NodeCount != nil {
+		in, out := &in.NodeCount, &out.NodeCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ChunkCount != nil {
+		in, out := &in.ChunkCount, &out.ChunkCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TabletCount != nil {
+		in, out := &in.TabletCount, &out.TabletCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TabletStaticMemory != nil {
+		in, out := &in.TabletStaticMemory, &out.TabletStaticMemory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountResources.
+func (in *AccountResources) DeepCopy() *AccountResources {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationSpec) DeepCopyInto(out *AuthenticationSpec) {
+	*out = *in
+	if in.Oidc != nil {
+		in, out := &in.Oidc, &out.Oidc
+		*out = new(OidcSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ldap != nil {
+		in, out := &in.Ldap, &out.Ldap
+		*out = new(LdapSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationSpec.
+func (in *AuthenticationSpec) DeepCopy() *AuthenticationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BaseLoggerSpec) DeepCopyInto(out *BaseLoggerSpec) {
 	*out = *in
@@ -137,6 +225,58 @@ func (in *CategoriesFilter) DeepCopy() *CategoriesFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CellBalancersSpec) DeepCopyInto(out *CellBalancersSpec) {
+	*out = *in
+	in.InstanceSpec.DeepCopyInto(&out.InstanceSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CellBalancersSpec.
+func (in *CellBalancersSpec) DeepCopy() *CellBalancersSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CellBalancersSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateIssuerReference) DeepCopyInto(out *CertificateIssuerReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateIssuerReference.
+func (in *CertificateIssuerReference) DeepCopy() *CertificateIssuerReference {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateIssuerReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateSpec) DeepCopyInto(out *CertificateSpec) {
+	*out = *in
+	out.IssuerRef = in.IssuerRef
+	if in.ExtraDNSNames != nil {
+		in, out := &in.ExtraDNSNames, &out.ExtraDNSNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateSpec.
+func (in *CertificateSpec) DeepCopy() *CertificateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Chyt) DeepCopyInto(out *Chyt) {
 	*out = *in
@@ -164,6 +304,140 @@ func (in *Chyt) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChytClique) DeepCopyInto(out *ChytClique) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChytClique.
+func (in *ChytClique) DeepCopy() *ChytClique {
+	if in == nil {
+		return nil
+	}
+	out := new(ChytClique)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChytClique) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChytCliqueACE) DeepCopyInto(out *ChytCliqueACE) {
+	*out = *in
+	if in.Subjects != nil {
+		in, out := &in.Subjects, &out.Subjects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChytCliqueACE.
+func (in *ChytCliqueACE) DeepCopy() *ChytCliqueACE {
+	if in == nil {
+		return nil
+	}
+	out := new(ChytCliqueACE)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChytCliqueList) DeepCopyInto(out *ChytCliqueList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChytClique, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChytCliqueList.
+func (in *ChytCliqueList) DeepCopy() *ChytCliqueList {
+	if in == nil {
+		return nil
+	}
+	out := new(ChytCliqueList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChytCliqueList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChytCliqueSpec) DeepCopyInto(out *ChytCliqueSpec) {
+	*out = *in
+	out.Ytsaurus = in.Ytsaurus
+	if in.InstanceMemory != nil {
+		in, out := &in.InstanceMemory, &out.InstanceMemory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.ACL != nil {
+		in, out := &in.ACL, &out.ACL
+		*out = make([]ChytCliqueACE, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChytCliqueSpec.
+func (in *ChytCliqueSpec) DeepCopy() *ChytCliqueSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ChytCliqueSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChytCliqueStatus) DeepCopyInto(out *ChytCliqueStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChytCliqueStatus.
+func (in *ChytCliqueStatus) DeepCopy() *ChytCliqueStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChytCliqueStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChytList) DeepCopyInto(out *ChytList) {
 	*out = *in
@@ -231,6 +505,11 @@ func (in *ChytStatus) DeepCopyInto(out *ChytStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ReleasedImages != nil {
+		in, out := &in.ReleasedImages, &out.ReleasedImages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChytStatus.
@@ -263,6 +542,43 @@ func (in *ClusterNodesSpec) DeepCopy() *ClusterNodesSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommonSpec) DeepCopyInto(out *CommonSpec) {
+	*out = *in
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]corev1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConfigOverrides != nil {
+		in, out := &in.ConfigOverrides, &out.ConfigOverrides
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.IPFamilyPolicy != nil {
+		in, out := &in.IPFamilyPolicy, &out.IPFamilyPolicy
+		*out = new(corev1.IPFamilyPolicyType)
+		**out = **in
+	}
+	if in.ExtraPodAnnotations != nil {
+		in, out := &in.ExtraPodAnnotations, &out.ExtraPodAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonSpec.
+func (in *CommonSpec) DeepCopy() *CommonSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CommonSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerAgentsSpec) DeepCopyInto(out *ControllerAgentsSpec) {
 	*out = *in
@@ -397,55 +713,169 @@ func (in *ExecNodesSpec) DeepCopy() *ExecNodesSpec {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HTTPProxiesSpec) DeepCopyInto(out *HTTPProxiesSpec) {
+func (in *ExternalAccessSpec) DeepCopyInto(out *ExternalAccessSpec) {
 	*out = *in
-	in.InstanceSpec.DeepCopyInto(&out.InstanceSpec)
-	in.Transport.DeepCopyInto(&out.Transport)
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(IngressSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPRoute != nil {
+		in, out := &in.HTTPRoute, &out.HTTPRoute
+		*out = new(HTTPRouteSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPProxiesSpec.
-func (in *HTTPProxiesSpec) DeepCopy() *HTTPProxiesSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalAccessSpec.
+func (in *ExternalAccessSpec) DeepCopy() *ExternalAccessSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(HTTPProxiesSpec)
+	out := new(ExternalAccessSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HTTPTransportSpec) DeepCopyInto(out *HTTPTransportSpec) {
+func (in *GatewayReference) DeepCopyInto(out *GatewayReference) {
 	*out = *in
-	if in.HTTPSSecret != nil {
-		in, out := &in.HTTPSSecret, &out.HTTPSSecret
-		*out = new(corev1.LocalObjectReference)
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.SectionName != nil {
+		in, out := &in.SectionName, &out.SectionName
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPTransportSpec.
-func (in *HTTPTransportSpec) DeepCopy() *HTTPTransportSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayReference.
+func (in *GatewayReference) DeepCopy() *GatewayReference {
 	if in == nil {
 		return nil
 	}
-	out := new(HTTPTransportSpec)
+	out := new(GatewayReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceSpec) DeepCopyInto(out *InstanceSpec) {
+func (in *HTTPProxiesSpec) DeepCopyInto(out *HTTPProxiesSpec) {
 	*out = *in
-	if in.Image != nil {
-		in, out := &in.Image, &out.Image
-		*out = new(string)
-		**out = **in
+	in.InstanceSpec.DeepCopyInto(&out.InstanceSpec)
+	in.Transport.DeepCopyInto(&out.Transport)
+	if in.ExternalAccess != nil {
+		in, out := &in.ExternalAccess, &out.ExternalAccess
+		*out = new(ExternalAccessSpec)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Volumes != nil {
-		in, out := &in.Volumes, &out.Volumes
-		*out = make([]corev1.Volume, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPProxiesSpec.
+func (in *HTTPProxiesSpec) DeepCopy() *HTTPProxiesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPProxiesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRouteSpec) DeepCopyInto(out *HTTPRouteSpec) {
+	*out = *in
+	if in.ParentRefs != nil {
+		in, out := &in.ParentRefs, &out.ParentRefs
+		*out = make([]GatewayReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRouteSpec.
+func (in *HTTPRouteSpec) DeepCopy() *HTTPRouteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRouteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPTransportSpec) DeepCopyInto(out *HTTPTransportSpec) {
+	*out = *in
+	if in.HTTPSSecret != nil {
+		in, out := &in.HTTPSSecret, &out.HTTPSSecret
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.HTTPSCertificate != nil {
+		in, out := &in.HTTPSCertificate, &out.HTTPSCertificate
+		*out = new(CertificateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPTransportSpec.
+func (in *HTTPTransportSpec) DeepCopy() *HTTPTransportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPTransportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressSpec) DeepCopyInto(out *IngressSpec) {
+	*out = *in
+	if in.IngressClassName != nil {
+		in, out := &in.IngressClassName, &out.IngressClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TLSSecret != nil {
+		in, out := &in.TLSSecret, &out.TLSSecret
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressSpec.
+func (in *IngressSpec) DeepCopy() *IngressSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceSpec) DeepCopyInto(out *InstanceSpec) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]corev1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 	if in.VolumeMounts != nil {
@@ -518,6 +948,75 @@ func (in *InstanceSpec) DeepCopy() *InstanceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegralGuaranteesSpec) DeepCopyInto(out *IntegralGuaranteesSpec) {
+	*out = *in
+	if in.BurstGuaranteeResources != nil {
+		in, out := &in.BurstGuaranteeResources, &out.BurstGuaranteeResources
+		*out = new(PoolResources)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceFlow != nil {
+		in, out := &in.ResourceFlow, &out.ResourceFlow
+		*out = new(PoolResources)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegralGuaranteesSpec.
+func (in *IntegralGuaranteesSpec) DeepCopy() *IntegralGuaranteesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegralGuaranteesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InternalTransportSpec) DeepCopyInto(out *InternalTransportSpec) {
+	*out = *in
+	out.IssuerRef = in.IssuerRef
+	if in.CASecret != nil {
+		in, out := &in.CASecret, &out.CASecret
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InternalTransportSpec.
+func (in *InternalTransportSpec) DeepCopy() *InternalTransportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InternalTransportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LdapSpec) DeepCopyInto(out *LdapSpec) {
+	*out = *in
+	in.BindPassword.DeepCopyInto(&out.BindPassword)
+	if in.GroupMapping != nil {
+		in, out := &in.GroupMapping, &out.GroupMapping
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LdapSpec.
+func (in *LdapSpec) DeepCopy() *LdapSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LdapSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocationSpec) DeepCopyInto(out *LocationSpec) {
 	*out = *in
@@ -568,10 +1067,31 @@ func (in *LogRotationPolicy) DeepCopy() *LogRotationPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MasterCachesSpec) DeepCopyInto(out *MasterCachesSpec) {
+	*out = *in
+	in.InstanceSpec.DeepCopyInto(&out.InstanceSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MasterCachesSpec.
+func (in *MasterCachesSpec) DeepCopy() *MasterCachesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MasterCachesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MastersSpec) DeepCopyInto(out *MastersSpec) {
 	*out = *in
 	in.InstanceSpec.DeepCopyInto(&out.InstanceSpec)
+	if in.HostAddresses != nil {
+		in, out := &in.HostAddresses, &out.HostAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MastersSpec.
@@ -584,6 +1104,31 @@ func (in *MastersSpec) DeepCopy() *MastersSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicySpec) DeepCopyInto(out *NetworkPolicySpec) {
+	*out = *in
+	if in.MonitoringNamespaceSelector != nil {
+		in, out := &in.MonitoringNamespaceSelector, &out.MonitoringNamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RemoteNodesNamespaceSelector != nil {
+		in, out := &in.RemoteNodesNamespaceSelector, &out.RemoteNodesNamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicySpec.
+func (in *NetworkPolicySpec) DeepCopy() *NetworkPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OauthServiceSpec) DeepCopyInto(out *OauthServiceSpec) {
 	*out = *in
@@ -620,6 +1165,69 @@ func (in *OauthUserInfoHandlerSpec) DeepCopy() *OauthUserInfoHandlerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OidcSpec) DeepCopyInto(out *OidcSpec) {
+	*out = *in
+	in.ClientSecret.DeepCopyInto(&out.ClientSecret)
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GroupMapping != nil {
+		in, out := &in.GroupMapping, &out.GroupMapping
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OidcSpec.
+func (in *OidcSpec) DeepCopy() *OidcSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OidcSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PoolResources) DeepCopyInto(out *PoolResources) {
+	*out = *in
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.GPU != nil {
+		in, out := &in.GPU, &out.GPU
+		*out = new(int)
+		**out = **in
+	}
+	if in.UserSlots != nil {
+		in, out := &in.UserSlots, &out.UserSlots
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PoolResources.
+func (in *PoolResources) DeepCopy() *PoolResources {
+	if in == nil {
+		return nil
+	}
+	out := new(PoolResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QueryTrackerSpec) DeepCopyInto(out *QueryTrackerSpec) {
 	*out = *in
@@ -636,6 +1244,22 @@ func (in *QueryTrackerSpec) DeepCopy() *QueryTrackerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueAgentSpec) DeepCopyInto(out *QueueAgentSpec) {
+	*out = *in
+	in.InstanceSpec.DeepCopyInto(&out.InstanceSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueAgentSpec.
+func (in *QueueAgentSpec) DeepCopy() *QueueAgentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueAgentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RPCProxiesSpec) DeepCopyInto(out *RPCProxiesSpec) {
 	*out = *in
@@ -666,36 +1290,1156 @@ func (in *RPCTransportSpec) DeepCopyInto(out *RPCTransportSpec) {
 		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
+	if in.TLSCertificate != nil {
+		in, out := &in.TLSCertificate, &out.TLSCertificate
+		*out = new(CertificateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RPCTransportSpec.
+func (in *RPCTransportSpec) DeepCopy() *RPCTransportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RPCTransportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteClusterSpec) DeepCopyInto(out *RemoteClusterSpec) {
+	*out = *in
+	if in.Ytsaurus != nil {
+		in, out := &in.Ytsaurus, &out.Ytsaurus
+		*out = new(RemoteYtsaurusReference)
+		**out = **in
+	}
+	if in.ClusterConnection != nil {
+		in, out := &in.ClusterConnection, &out.ClusterConnection
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteClusterSpec.
+func (in *RemoteClusterSpec) DeepCopy() *RemoteClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteDataNodes) DeepCopyInto(out *RemoteDataNodes) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteDataNodes.
+func (in *RemoteDataNodes) DeepCopy() *RemoteDataNodes {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteDataNodes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RemoteDataNodes) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteDataNodesList) DeepCopyInto(out *RemoteDataNodesList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RemoteDataNodes, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteDataNodesList.
+func (in *RemoteDataNodesList) DeepCopy() *RemoteDataNodesList {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteDataNodesList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RemoteDataNodesList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteDataNodesSpec) DeepCopyInto(out *RemoteDataNodesSpec) {
+	*out = *in
+	if in.InternalTransport != nil {
+		in, out := &in.InternalTransport, &out.InternalTransport
+		*out = new(InternalTransportSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.CommonSpec.DeepCopyInto(&out.CommonSpec)
+	in.DataNodesSpec.DeepCopyInto(&out.DataNodesSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteDataNodesSpec.
+func (in *RemoteDataNodesSpec) DeepCopy() *RemoteDataNodesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteDataNodesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteDataNodesStatus) DeepCopyInto(out *RemoteDataNodesStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteDataNodesStatus.
+func (in *RemoteDataNodesStatus) DeepCopy() *RemoteDataNodesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteDataNodesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteExecNodes) DeepCopyInto(out *RemoteExecNodes) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteExecNodes.
+func (in *RemoteExecNodes) DeepCopy() *RemoteExecNodes {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteExecNodes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RemoteExecNodes) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteExecNodesList) DeepCopyInto(out *RemoteExecNodesList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RemoteExecNodes, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteExecNodesList.
+func (in *RemoteExecNodesList) DeepCopy() *RemoteExecNodesList {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteExecNodesList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RemoteExecNodesList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteExecNodesSpec) DeepCopyInto(out *RemoteExecNodesSpec) {
+	*out = *in
+	if in.InternalTransport != nil {
+		in, out := &in.InternalTransport, &out.InternalTransport
+		*out = new(InternalTransportSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.CommonSpec.DeepCopyInto(&out.CommonSpec)
+	in.ExecNodesSpec.DeepCopyInto(&out.ExecNodesSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteExecNodesSpec.
+func (in *RemoteExecNodesSpec) DeepCopy() *RemoteExecNodesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteExecNodesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteExecNodesStatus) DeepCopyInto(out *RemoteExecNodesStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteExecNodesStatus.
+func (in *RemoteExecNodesStatus) DeepCopy() *RemoteExecNodesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteExecNodesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteYtsaurusReference) DeepCopyInto(out *RemoteYtsaurusReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteYtsaurusReference.
+func (in *RemoteYtsaurusReference) DeepCopy() *RemoteYtsaurusReference {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteYtsaurusReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicatedTableTrackerSpec) DeepCopyInto(out *ReplicatedTableTrackerSpec) {
+	*out = *in
+	in.InstanceSpec.DeepCopyInto(&out.InstanceSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicatedTableTrackerSpec.
+func (in *ReplicatedTableTrackerSpec) DeepCopy() *ReplicatedTableTrackerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicatedTableTrackerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RobotTokenRotationSpec) DeepCopyInto(out *RobotTokenRotationSpec) {
+	*out = *in
+	if in.Period != nil {
+		in, out := &in.Period, &out.Period
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RotateBefore != nil {
+		in, out := &in.RotateBefore, &out.RotateBefore
+		*out = (*in).DeepCopy()
+	}
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotTokenRotationSpec.
+func (in *RobotTokenRotationSpec) DeepCopy() *RobotTokenRotationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RobotTokenRotationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulerPool) DeepCopyInto(out *SchedulerPool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulerPool.
+func (in *SchedulerPool) DeepCopy() *SchedulerPool {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulerPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SchedulerPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulerPoolList) DeepCopyInto(out *SchedulerPoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SchedulerPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulerPoolList.
+func (in *SchedulerPoolList) DeepCopy() *SchedulerPoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulerPoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SchedulerPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulerPoolSpec) DeepCopyInto(out *SchedulerPoolSpec) {
+	*out = *in
+	out.Ytsaurus = in.Ytsaurus
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.StrongGuaranteeResources != nil {
+		in, out := &in.StrongGuaranteeResources, &out.StrongGuaranteeResources
+		*out = new(PoolResources)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IntegralGuarantees != nil {
+		in, out := &in.IntegralGuarantees, &out.IntegralGuarantees
+		*out = new(IntegralGuaranteesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxOperationCount != nil {
+		in, out := &in.MaxOperationCount, &out.MaxOperationCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxRunningOperationCount != nil {
+		in, out := &in.MaxRunningOperationCount, &out.MaxRunningOperationCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.SchedulingTagFilter != nil {
+		in, out := &in.SchedulingTagFilter, &out.SchedulingTagFilter
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulerPoolSpec.
+func (in *SchedulerPoolSpec) DeepCopy() *SchedulerPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulerPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulerPoolStatus) DeepCopyInto(out *SchedulerPoolStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulerPoolStatus.
+func (in *SchedulerPoolStatus) DeepCopy() *SchedulerPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulerPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulerPoolTree) DeepCopyInto(out *SchedulerPoolTree) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulerPoolTree.
+func (in *SchedulerPoolTree) DeepCopy() *SchedulerPoolTree {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulerPoolTree)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SchedulerPoolTree) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulerPoolTreeList) DeepCopyInto(out *SchedulerPoolTreeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SchedulerPoolTree, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulerPoolTreeList.
+func (in *SchedulerPoolTreeList) DeepCopy() *SchedulerPoolTreeList {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulerPoolTreeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SchedulerPoolTreeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulerPoolTreeSpec) DeepCopyInto(out *SchedulerPoolTreeSpec) {
+	*out = *in
+	out.Ytsaurus = in.Ytsaurus
+	if in.NodesFilter != nil {
+		in, out := &in.NodesFilter, &out.NodesFilter
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultParentPool != nil {
+		in, out := &in.DefaultParentPool, &out.DefaultParentPool
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxOperationCountPerPool != nil {
+		in, out := &in.MaxOperationCountPerPool, &out.MaxOperationCountPerPool
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxRunningOperationCountPerPool != nil {
+		in, out := &in.MaxRunningOperationCountPerPool, &out.MaxRunningOperationCountPerPool
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulerPoolTreeSpec.
+func (in *SchedulerPoolTreeSpec) DeepCopy() *SchedulerPoolTreeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulerPoolTreeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulerPoolTreeStatus) DeepCopyInto(out *SchedulerPoolTreeStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulerPoolTreeStatus.
+func (in *SchedulerPoolTreeStatus) DeepCopy() *SchedulerPoolTreeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulerPoolTreeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulersSpec) DeepCopyInto(out *SchedulersSpec) {
+	*out = *in
+	in.InstanceSpec.DeepCopyInto(&out.InstanceSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulersSpec.
+func (in *SchedulersSpec) DeepCopy() *SchedulersSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulersSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Spyt) DeepCopyInto(out *Spyt) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Spyt.
+func (in *Spyt) DeepCopy() *Spyt {
+	if in == nil {
+		return nil
+	}
+	out := new(Spyt)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Spyt) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpytCluster) DeepCopyInto(out *SpytCluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpytCluster.
+func (in *SpytCluster) DeepCopy() *SpytCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(SpytCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SpytCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpytClusterList) DeepCopyInto(out *SpytClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SpytCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpytClusterList.
+func (in *SpytClusterList) DeepCopy() *SpytClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(SpytClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SpytClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpytClusterSpec) DeepCopyInto(out *SpytClusterSpec) {
+	*out = *in
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]corev1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	out.Ytsaurus = in.Ytsaurus
+	out.WorkerMemory = in.WorkerMemory.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpytClusterSpec.
+func (in *SpytClusterSpec) DeepCopy() *SpytClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SpytClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpytClusterStatus) DeepCopyInto(out *SpytClusterStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpytClusterStatus.
+func (in *SpytClusterStatus) DeepCopy() *SpytClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SpytClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpytList) DeepCopyInto(out *SpytList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Spyt, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpytList.
+func (in *SpytList) DeepCopy() *SpytList {
+	if in == nil {
+		return nil
+	}
+	out := new(SpytList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SpytList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpytSpec) DeepCopyInto(out *SpytSpec) {
+	*out = *in
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]corev1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ytsaurus != nil {
+		in, out := &in.Ytsaurus, &out.Ytsaurus
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpytSpec.
+func (in *SpytSpec) DeepCopy() *SpytSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SpytSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpytStatus) DeepCopyInto(out *SpytStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ReleasedImages != nil {
+		in, out := &in.ReleasedImages, &out.ReleasedImages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpytStatus.
+func (in *SpytStatus) DeepCopy() *SpytStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SpytStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StrawberryControllerSpec) DeepCopyInto(out *StrawberryControllerSpec) {
+	*out = *in
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StrawberryControllerSpec.
+func (in *StrawberryControllerSpec) DeepCopy() *StrawberryControllerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StrawberryControllerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StructuredLoggerSpec) DeepCopyInto(out *StructuredLoggerSpec) {
+	*out = *in
+	in.BaseLoggerSpec.DeepCopyInto(&out.BaseLoggerSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StructuredLoggerSpec.
+func (in *StructuredLoggerSpec) DeepCopy() *StructuredLoggerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StructuredLoggerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPProxiesSpec) DeepCopyInto(out *TCPProxiesSpec) {
+	*out = *in
+	in.InstanceSpec.DeepCopyInto(&out.InstanceSpec)
+	if in.ServiceType != nil {
+		in, out := &in.ServiceType, &out.ServiceType
+		*out = new(corev1.ServiceType)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPProxiesSpec.
+func (in *TCPProxiesSpec) DeepCopy() *TCPProxiesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPProxiesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TabletBalancersSpec) DeepCopyInto(out *TabletBalancersSpec) {
+	*out = *in
+	in.InstanceSpec.DeepCopyInto(&out.InstanceSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TabletBalancersSpec.
+func (in *TabletBalancersSpec) DeepCopy() *TabletBalancersSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TabletBalancersSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TabletCellBundle) DeepCopyInto(out *TabletCellBundle) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TabletCellBundle.
+func (in *TabletCellBundle) DeepCopy() *TabletCellBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(TabletCellBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TabletCellBundle) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TabletCellBundleInfo) DeepCopyInto(out *TabletCellBundleInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TabletCellBundleInfo.
+func (in *TabletCellBundleInfo) DeepCopy() *TabletCellBundleInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(TabletCellBundleInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TabletCellBundleList) DeepCopyInto(out *TabletCellBundleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TabletCellBundle, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TabletCellBundleList.
+func (in *TabletCellBundleList) DeepCopy() *TabletCellBundleList {
+	if in == nil {
+		return nil
+	}
+	out := new(TabletCellBundleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TabletCellBundleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TabletCellBundleSpec) DeepCopyInto(out *TabletCellBundleSpec) {
+	*out = *in
+	out.Ytsaurus = in.Ytsaurus
+	if in.NodeTagFilter != nil {
+		in, out := &in.NodeTagFilter, &out.NodeTagFilter
+		*out = new(string)
+		**out = **in
+	}
+	if in.ChangelogPrimaryMedium != nil {
+		in, out := &in.ChangelogPrimaryMedium, &out.ChangelogPrimaryMedium
+		*out = new(string)
+		**out = **in
+	}
+	if in.SnapshotPrimaryMedium != nil {
+		in, out := &in.SnapshotPrimaryMedium, &out.SnapshotPrimaryMedium
+		*out = new(string)
+		**out = **in
+	}
+	if in.ChangelogReplicationFactor != nil {
+		in, out := &in.ChangelogReplicationFactor, &out.ChangelogReplicationFactor
+		*out = new(int)
+		**out = **in
+	}
+	if in.SnapshotReplicationFactor != nil {
+		in, out := &in.SnapshotReplicationFactor, &out.SnapshotReplicationFactor
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TabletCellBundleSpec.
+func (in *TabletCellBundleSpec) DeepCopy() *TabletCellBundleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TabletCellBundleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TabletCellBundleStatus) DeepCopyInto(out *TabletCellBundleStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TabletCellBundleStatus.
+func (in *TabletCellBundleStatus) DeepCopy() *TabletCellBundleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TabletCellBundleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TabletNodesSpec) DeepCopyInto(out *TabletNodesSpec) {
+	*out = *in
+	in.InstanceSpec.DeepCopyInto(&out.InstanceSpec)
+	in.ClusterNodesSpec.DeepCopyInto(&out.ClusterNodesSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TabletNodesSpec.
+func (in *TabletNodesSpec) DeepCopy() *TabletNodesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TabletNodesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TextLoggerSpec) DeepCopyInto(out *TextLoggerSpec) {
+	*out = *in
+	in.BaseLoggerSpec.DeepCopyInto(&out.BaseLoggerSpec)
+	if in.CategoriesFilter != nil {
+		in, out := &in.CategoriesFilter, &out.CategoriesFilter
+		*out = new(CategoriesFilter)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TextLoggerSpec.
+func (in *TextLoggerSpec) DeepCopy() *TextLoggerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TextLoggerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimestampProvidersSpec) DeepCopyInto(out *TimestampProvidersSpec) {
+	*out = *in
+	in.InstanceSpec.DeepCopyInto(&out.InstanceSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimestampProvidersSpec.
+func (in *TimestampProvidersSpec) DeepCopy() *TimestampProvidersSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TimestampProvidersSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UISpec) DeepCopyInto(out *UISpec) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.OdinBaseUrl != nil {
+		in, out := &in.OdinBaseUrl, &out.OdinBaseUrl
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExtraEnvVariables != nil {
+		in, out := &in.ExtraEnvVariables, &out.ExtraEnvVariables
+		*out = make([]corev1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExternalAccess != nil {
+		in, out := &in.ExternalAccess, &out.ExternalAccess
+		*out = new(ExternalAccessSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UISpec.
+func (in *UISpec) DeepCopy() *UISpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UISpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpdateStatus) DeepCopyInto(out *UpdateStatus) {
+	*out = *in
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TabletCellBundles != nil {
+		in, out := &in.TabletCellBundles, &out.TabletCellBundles
+		*out = make([]TabletCellBundleInfo, len(*in))
+		copy(*out, *in)
+	}
+	if in.MasterMonitoringPaths != nil {
+		in, out := &in.MasterMonitoringPaths, &out.MasterMonitoringPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RPCTransportSpec.
-func (in *RPCTransportSpec) DeepCopy() *RPCTransportSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdateStatus.
+func (in *UpdateStatus) DeepCopy() *UpdateStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RPCTransportSpec)
+	out := new(UpdateStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SchedulersSpec) DeepCopyInto(out *SchedulersSpec) {
+func (in *YQLAgentSpec) DeepCopyInto(out *YQLAgentSpec) {
 	*out = *in
 	in.InstanceSpec.DeepCopyInto(&out.InstanceSpec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulersSpec.
-func (in *SchedulersSpec) DeepCopy() *SchedulersSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YQLAgentSpec.
+func (in *YQLAgentSpec) DeepCopy() *YQLAgentSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SchedulersSpec)
+	out := new(YQLAgentSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Spyt) DeepCopyInto(out *Spyt) {
+func (in *YtAccount) DeepCopyInto(out *YtAccount) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -703,18 +2447,18 @@ func (in *Spyt) DeepCopyInto(out *Spyt) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Spyt.
-func (in *Spyt) DeepCopy() *Spyt {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YtAccount.
+func (in *YtAccount) DeepCopy() *YtAccount {
 	if in == nil {
 		return nil
 	}
-	out := new(Spyt)
+	out := new(YtAccount)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Spyt) DeepCopyObject() runtime.Object {
+func (in *YtAccount) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -722,31 +2466,31 @@ func (in *Spyt) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SpytList) DeepCopyInto(out *SpytList) {
+func (in *YtAccountList) DeepCopyInto(out *YtAccountList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Spyt, len(*in))
+		*out = make([]YtAccount, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpytList.
-func (in *SpytList) DeepCopy() *SpytList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YtAccountList.
+func (in *YtAccountList) DeepCopy() *YtAccountList {
 	if in == nil {
 		return nil
 	}
-	out := new(SpytList)
+	out := new(YtAccountList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SpytList) DeepCopyObject() runtime.Object {
+func (in *YtAccountList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -754,32 +2498,24 @@ func (in *SpytList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SpytSpec) DeepCopyInto(out *SpytSpec) {
+func (in *YtAccountSpec) DeepCopyInto(out *YtAccountSpec) {
 	*out = *in
-	if in.ImagePullSecrets != nil {
-		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
-		*out = make([]corev1.LocalObjectReference, len(*in))
-		copy(*out, *in)
-	}
-	if in.Ytsaurus != nil {
-		in, out := &in.Ytsaurus, &out.Ytsaurus
-		*out = new(corev1.LocalObjectReference)
-		**out = **in
-	}
+	out.Ytsaurus = in.Ytsaurus
+	in.ResourceLimits.DeepCopyInto(&out.ResourceLimits)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpytSpec.
-func (in *SpytSpec) DeepCopy() *SpytSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YtAccountSpec.
+func (in *YtAccountSpec) DeepCopy() *YtAccountSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SpytSpec)
+	out := new(YtAccountSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SpytStatus) DeepCopyInto(out *SpytStatus) {
+func (in *YtAccountStatus) DeepCopyInto(out *YtAccountStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -788,180 +2524,219 @@ func (in *SpytStatus) DeepCopyInto(out *SpytStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.ResourceUsage.DeepCopyInto(&out.ResourceUsage)
+	in.ResourceLimits.DeepCopyInto(&out.ResourceLimits)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpytStatus.
-func (in *SpytStatus) DeepCopy() *SpytStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YtAccountStatus.
+func (in *YtAccountStatus) DeepCopy() *YtAccountStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SpytStatus)
+	out := new(YtAccountStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *StrawberryControllerSpec) DeepCopyInto(out *StrawberryControllerSpec) {
+func (in *YtGroup) DeepCopyInto(out *YtGroup) {
 	*out = *in
-	in.Resources.DeepCopyInto(&out.Resources)
-	if in.Image != nil {
-		in, out := &in.Image, &out.Image
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StrawberryControllerSpec.
-func (in *StrawberryControllerSpec) DeepCopy() *StrawberryControllerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YtGroup.
+func (in *YtGroup) DeepCopy() *YtGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(StrawberryControllerSpec)
+	out := new(YtGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *YtGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *StructuredLoggerSpec) DeepCopyInto(out *StructuredLoggerSpec) {
+func (in *YtGroupList) DeepCopyInto(out *YtGroupList) {
 	*out = *in
-	in.BaseLoggerSpec.DeepCopyInto(&out.BaseLoggerSpec)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]YtGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StructuredLoggerSpec.
-func (in *StructuredLoggerSpec) DeepCopy() *StructuredLoggerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YtGroupList.
+func (in *YtGroupList) DeepCopy() *YtGroupList {
 	if in == nil {
 		return nil
 	}
-	out := new(StructuredLoggerSpec)
+	out := new(YtGroupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *YtGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TCPProxiesSpec) DeepCopyInto(out *TCPProxiesSpec) {
+func (in *YtGroupSpec) DeepCopyInto(out *YtGroupSpec) {
 	*out = *in
-	in.InstanceSpec.DeepCopyInto(&out.InstanceSpec)
-	if in.ServiceType != nil {
-		in, out := &in.ServiceType, &out.ServiceType
-		*out = new(corev1.ServiceType)
-		**out = **in
+	out.Ytsaurus = in.Ytsaurus
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ACL != nil {
+		in, out := &in.ACL, &out.ACL
+		*out = make([]ACESpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPProxiesSpec.
-func (in *TCPProxiesSpec) DeepCopy() *TCPProxiesSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YtGroupSpec.
+func (in *YtGroupSpec) DeepCopy() *YtGroupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(TCPProxiesSpec)
+	out := new(YtGroupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TabletCellBundleInfo) DeepCopyInto(out *TabletCellBundleInfo) {
+func (in *YtGroupStatus) DeepCopyInto(out *YtGroupStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ACLPaths != nil {
+		in, out := &in.ACLPaths, &out.ACLPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TabletCellBundleInfo.
-func (in *TabletCellBundleInfo) DeepCopy() *TabletCellBundleInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YtGroupStatus.
+func (in *YtGroupStatus) DeepCopy() *YtGroupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(TabletCellBundleInfo)
+	out := new(YtGroupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TabletNodesSpec) DeepCopyInto(out *TabletNodesSpec) {
+func (in *YtUser) DeepCopyInto(out *YtUser) {
 	*out = *in
-	in.InstanceSpec.DeepCopyInto(&out.InstanceSpec)
-	in.ClusterNodesSpec.DeepCopyInto(&out.ClusterNodesSpec)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TabletNodesSpec.
-func (in *TabletNodesSpec) DeepCopy() *TabletNodesSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YtUser.
+func (in *YtUser) DeepCopy() *YtUser {
 	if in == nil {
 		return nil
 	}
-	out := new(TabletNodesSpec)
+	out := new(YtUser)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *YtUser) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TextLoggerSpec) DeepCopyInto(out *TextLoggerSpec) {
+func (in *YtUserList) DeepCopyInto(out *YtUserList) {
 	*out = *in
-	in.BaseLoggerSpec.DeepCopyInto(&out.BaseLoggerSpec)
-	if in.CategoriesFilter != nil {
-		in, out := &in.CategoriesFilter, &out.CategoriesFilter
-		*out = new(CategoriesFilter)
-		(*in).DeepCopyInto(*out)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]YtUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TextLoggerSpec.
-func (in *TextLoggerSpec) DeepCopy() *TextLoggerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YtUserList.
+func (in *YtUserList) DeepCopy() *YtUserList {
 	if in == nil {
 		return nil
 	}
-	out := new(TextLoggerSpec)
+	out := new(YtUserList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *YtUserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *UISpec) DeepCopyInto(out *UISpec) {
+func (in *YtUserSpec) DeepCopyInto(out *YtUserSpec) {
 	*out = *in
-	if in.Image != nil {
-		in, out := &in.Image, &out.Image
-		*out = new(string)
-		**out = **in
-	}
-	in.Resources.DeepCopyInto(&out.Resources)
-	if in.OdinBaseUrl != nil {
-		in, out := &in.OdinBaseUrl, &out.OdinBaseUrl
-		*out = new(string)
-		**out = **in
-	}
-	if in.ExtraEnvVariables != nil {
-		in, out := &in.ExtraEnvVariables, &out.ExtraEnvVariables
-		*out = make([]corev1.EnvVar, len(*in))
+	out.Ytsaurus = in.Ytsaurus
+	if in.ACL != nil {
+		in, out := &in.ACL, &out.ACL
+		*out = make([]ACESpec, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
-	}
-	if in.Group != nil {
-		in, out := &in.Group, &out.Group
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UISpec.
-func (in *UISpec) DeepCopy() *UISpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YtUserSpec.
+func (in *YtUserSpec) DeepCopy() *YtUserSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(UISpec)
+	out := new(YtUserSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *UpdateStatus) DeepCopyInto(out *UpdateStatus) {
+func (in *YtUserStatus) DeepCopyInto(out *YtUserStatus) {
 	*out = *in
-	if in.Components != nil {
-		in, out := &in.Components, &out.Components
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]metav1.Condition, len(*in))
@@ -969,40 +2744,19 @@ func (in *UpdateStatus) DeepCopyInto(out *UpdateStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.TabletCellBundles != nil {
-		in, out := &in.TabletCellBundles, &out.TabletCellBundles
-		*out = make([]TabletCellBundleInfo, len(*in))
-		copy(*out, *in)
-	}
-	if in.MasterMonitoringPaths != nil {
-		in, out := &in.MasterMonitoringPaths, &out.MasterMonitoringPaths
+	if in.ACLPaths != nil {
+		in, out := &in.ACLPaths, &out.ACLPaths
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdateStatus.
-func (in *UpdateStatus) DeepCopy() *UpdateStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(UpdateStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *YQLAgentSpec) DeepCopyInto(out *YQLAgentSpec) {
-	*out = *in
-	in.InstanceSpec.DeepCopyInto(&out.InstanceSpec)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YQLAgentSpec.
-func (in *YQLAgentSpec) DeepCopy() *YQLAgentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YtUserStatus.
+func (in *YtUserStatus) DeepCopy() *YtUserStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(YQLAgentSpec)
+	out := new(YtUserStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1069,16 +2823,7 @@ func (in *YtsaurusList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *YtsaurusSpec) DeepCopyInto(out *YtsaurusSpec) {
 	*out = *in
-	if in.ImagePullSecrets != nil {
-		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
-		*out = make([]corev1.LocalObjectReference, len(*in))
-		copy(*out, *in)
-	}
-	if in.ConfigOverrides != nil {
-		in, out := &in.ConfigOverrides, &out.ConfigOverrides
-		*out = new(corev1.LocalObjectReference)
-		**out = **in
-	}
+	in.CommonSpec.DeepCopyInto(&out.CommonSpec)
 	if in.AdminCredentials != nil {
 		in, out := &in.AdminCredentials, &out.AdminCredentials
 		*out = new(corev1.LocalObjectReference)
@@ -1089,12 +2834,10 @@ func (in *YtsaurusSpec) DeepCopyInto(out *YtsaurusSpec) {
 		*out = new(OauthServiceSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ExtraPodAnnotations != nil {
-		in, out := &in.ExtraPodAnnotations, &out.ExtraPodAnnotations
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(AuthenticationSpec)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Bootstrap != nil {
 		in, out := &in.Bootstrap, &out.Bootstrap
@@ -1110,6 +2853,16 @@ func (in *YtsaurusSpec) DeepCopyInto(out *YtsaurusSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.MasterCaches != nil {
+		in, out := &in.MasterCaches, &out.MasterCaches
+		*out = new(MasterCachesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TimestampProviders != nil {
+		in, out := &in.TimestampProviders, &out.TimestampProviders
+		*out = new(TimestampProvidersSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.HTTPProxies != nil {
 		in, out := &in.HTTPProxies, &out.HTTPProxies
 		*out = make([]HTTPProxiesSpec, len(*in))
@@ -1162,6 +2915,21 @@ func (in *YtsaurusSpec) DeepCopyInto(out *YtsaurusSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TabletBalancers != nil {
+		in, out := &in.TabletBalancers, &out.TabletBalancers
+		*out = new(TabletBalancersSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CellBalancers != nil {
+		in, out := &in.CellBalancers, &out.CellBalancers
+		*out = new(CellBalancersSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReplicatedTableTrackers != nil {
+		in, out := &in.ReplicatedTableTrackers, &out.ReplicatedTableTrackers
+		*out = new(ReplicatedTableTrackerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.StrawberryController != nil {
 		in, out := &in.StrawberryController, &out.StrawberryController
 		*out = new(StrawberryControllerSpec)
@@ -1177,6 +2945,11 @@ func (in *YtsaurusSpec) DeepCopyInto(out *YtsaurusSpec) {
 		*out = new(QueryTrackerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.QueueAgents != nil {
+		in, out := &in.QueueAgents, &out.QueueAgents
+		*out = new(QueueAgentSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Spyt != nil {
 		in, out := &in.Spyt, &out.Spyt
 		*out = new(DeprecatedSpytSpec)
@@ -1192,6 +2965,28 @@ func (in *YtsaurusSpec) DeepCopyInto(out *YtsaurusSpec) {
 		*out = new(UISpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RemoteClusters != nil {
+		in, out := &in.RemoteClusters, &out.RemoteClusters
+		*out = make([]RemoteClusterSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InternalTransport != nil {
+		in, out := &in.InternalTransport, &out.InternalTransport
+		*out = new(InternalTransportSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(NetworkPolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RobotTokenRotation != nil {
+		in, out := &in.RobotTokenRotation, &out.RobotTokenRotation
+		*out = new(RobotTokenRotationSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YtsaurusSpec.
@@ -1215,6 +3010,11 @@ func (in *YtsaurusStatus) DeepCopyInto(out *YtsaurusStatus) {
 		}
 	}
 	in.UpdateStatus.DeepCopyInto(&out.UpdateStatus)
+	if in.RemoteClusters != nil {
+		in, out := &in.RemoteClusters, &out.RemoteClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YtsaurusStatus.
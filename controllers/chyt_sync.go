@@ -26,8 +26,20 @@ func (r *ChytReconciler) Sync(ctx context.Context, resource *ytv1.Chyt, ytsaurus
 		return ctrl.Result{Requeue: true}, err
 	}
 
-	if chyt.GetResource().Status.ReleaseStatus == ytv1.ChytReleaseStatusFinished {
-		return ctrl.Result{}, nil
+	if status := chyt.GetResource().Status; status.ReleaseStatus == ytv1.ChytReleaseStatusFinished {
+		if status.ObservedImage == "" {
+			// The resource was released before the image was recorded in status.
+			chyt.GetResource().Status.ObservedImage = resource.Spec.Image
+			chyt.GetResource().Status.ObservedGeneration = resource.Generation
+			chyt.GetResource().Status.ReleasedImages = []string{resource.Spec.Image}
+			return ctrl.Result{}, chyt.APIProxy().UpdateStatus(ctx)
+		}
+
+		if status.ObservedImage == resource.Spec.Image {
+			return ctrl.Result{}, nil
+		}
+
+		logger.Info("CHYT image changed, releasing", "image", resource.Spec.Image, "previousImage", status.ObservedImage)
 	}
 
 	status := component.Status(ctx)
@@ -0,0 +1,37 @@
+package controllers
+
+import (
+	"context"
+
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/components"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
+	"go.ytsaurus.tech/yt/go/yt"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func (r *ChytCliqueReconciler) Sync(ctx context.Context, resource *ytv1.ChytClique) (ctrl.Result, error) {
+	clique := apiproxy.NewChytClique(resource, r.Client, r.Recorder, r.Scheme)
+
+	return syncCypressObject(ctx, r.YtClients, cypressObjectSync{
+		name:         "chytClique",
+		resource:     resource,
+		apiProxy:     clique.APIProxy(),
+		ytsaurusName: resource.Spec.Ytsaurus.Name,
+		newComponent: func(ytsaurus *ytv1.Ytsaurus, ytClient yt.Client) (cypressObject, error) {
+			if ytsaurus.Spec.StrawberryController == nil && ytsaurus.Spec.DeprecatedChytController == nil {
+				return components.NewChytClique(clique, ytClient, nil), nil
+			}
+
+			token, err := getOperatorToken(ctx, r.Client, ytsaurus)
+			if err != nil {
+				return nil, err
+			}
+
+			cfgen := ytconfig.NewGenerator(ytsaurus, getClusterDomain(r.Client))
+			strawberryClient := components.NewStrawberryClient(cfgen, "chyt", token)
+			return components.NewChytClique(clique, ytClient, strawberryClient), nil
+		},
+	})
+}
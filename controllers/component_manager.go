@@ -15,7 +15,9 @@ type ComponentManager struct {
 	ytsaurus              *apiProxy.Ytsaurus
 	allComponents         []components.Component
 	queryTrackerComponent components.Component
+	queueAgentComponent   components.Component
 	schedulerComponent    components.Component
+	cypressStateComponent components.Component
 	status                ComponentManagerStatus
 }
 
@@ -37,16 +39,26 @@ func NewComponentManager(
 
 	d := components.NewDiscovery(cfgen, ytsaurus)
 	m := components.NewMaster(cfgen, ytsaurus)
+
+	// Proxies and nodes go through master caches when they are deployed,
+	// so they have to wait for the caches rather than for the masters.
+	var msc components.Component
+	masterDependency := m
+	if resource.Spec.MasterCaches != nil {
+		msc = components.NewMasterCache(cfgen, ytsaurus, m)
+		masterDependency = msc
+	}
+
 	var hps []components.Component
 	for _, hpSpec := range ytsaurus.GetResource().Spec.HTTPProxies {
-		hps = append(hps, components.NewHTTPProxy(cfgen, ytsaurus, m, hpSpec))
+		hps = append(hps, components.NewHTTPProxy(cfgen, ytsaurus, masterDependency, hpSpec))
 	}
 	yc := components.NewYtsaurusClient(cfgen, ytsaurus, hps[0])
 
 	var dnds []components.Component
 	if resource.Spec.DataNodes != nil && len(resource.Spec.DataNodes) > 0 {
 		for _, dndSpec := range ytsaurus.GetResource().Spec.DataNodes {
-			dnds = append(dnds, components.NewDataNode(cfgen, ytsaurus, m, dndSpec))
+			dnds = append(dnds, components.NewDataNode(cfgen, ytsaurus, masterDependency, dndSpec))
 		}
 	}
 
@@ -55,6 +67,14 @@ func NewComponentManager(
 	allComponents := []components.Component{
 		d, m, yc,
 	}
+	if msc != nil {
+		allComponents = append(allComponents, msc)
+	}
+
+	if resource.Spec.TimestampProviders != nil {
+		tsp := components.NewTimestampProvider(cfgen, ytsaurus, m)
+		allComponents = append(allComponents, tsp)
+	}
 	allComponents = append(allComponents, dnds...)
 	allComponents = append(allComponents, hps...)
 
@@ -66,7 +86,7 @@ func NewComponentManager(
 	if resource.Spec.RPCProxies != nil && len(resource.Spec.RPCProxies) > 0 {
 		var rps []components.Component
 		for _, rpSpec := range ytsaurus.GetResource().Spec.RPCProxies {
-			rps = append(rps, components.NewRPCProxy(cfgen, ytsaurus, m, rpSpec))
+			rps = append(rps, components.NewRPCProxy(cfgen, ytsaurus, masterDependency, rpSpec))
 		}
 		allComponents = append(allComponents, rps...)
 	}
@@ -74,7 +94,7 @@ func NewComponentManager(
 	if resource.Spec.TCPProxies != nil && len(resource.Spec.TCPProxies) > 0 {
 		var tps []components.Component
 		for _, tpSpec := range ytsaurus.GetResource().Spec.TCPProxies {
-			tps = append(tps, components.NewTCPProxy(cfgen, ytsaurus, m, tpSpec))
+			tps = append(tps, components.NewTCPProxy(cfgen, ytsaurus, masterDependency, tpSpec))
 		}
 		allComponents = append(allComponents, tps...)
 	}
@@ -82,7 +102,7 @@ func NewComponentManager(
 	var ends []components.Component
 	if resource.Spec.ExecNodes != nil && len(resource.Spec.ExecNodes) > 0 {
 		for _, endSpec := range ytsaurus.GetResource().Spec.ExecNodes {
-			ends = append(ends, components.NewExecNode(cfgen, ytsaurus, m, endSpec))
+			ends = append(ends, components.NewExecNode(cfgen, ytsaurus, masterDependency, endSpec))
 		}
 	}
 	allComponents = append(allComponents, ends...)
@@ -95,6 +115,21 @@ func NewComponentManager(
 	}
 	allComponents = append(allComponents, tnds...)
 
+	if resource.Spec.TabletBalancers != nil && len(tnds) > 0 {
+		tb := components.NewTabletBalancer(cfgen, ytsaurus, m)
+		allComponents = append(allComponents, tb)
+	}
+
+	if resource.Spec.CellBalancers != nil && len(tnds) > 0 {
+		cb := components.NewCellBalancer(cfgen, ytsaurus, m)
+		allComponents = append(allComponents, cb)
+	}
+
+	if resource.Spec.ReplicatedTableTrackers != nil && len(tnds) > 0 {
+		rtt := components.NewReplicatedTableTracker(cfgen, ytsaurus, m)
+		allComponents = append(allComponents, rtt)
+	}
+
 	if resource.Spec.Schedulers != nil {
 		s = components.NewScheduler(cfgen, ytsaurus, m, ends, tnds)
 		allComponents = append(allComponents, s)
@@ -111,6 +146,12 @@ func NewComponentManager(
 		allComponents = append(allComponents, q)
 	}
 
+	var qa components.Component
+	if resource.Spec.QueueAgents != nil && resource.Spec.TabletNodes != nil && len(resource.Spec.TabletNodes) > 0 {
+		qa = components.NewQueueAgent(cfgen, ytsaurus, yc, tnds)
+		allComponents = append(allComponents, qa)
+	}
+
 	if resource.Spec.YQLAgents != nil {
 		yqla := components.NewYQLAgent(cfgen, ytsaurus, m)
 		allComponents = append(allComponents, yqla)
@@ -121,6 +162,11 @@ func NewComponentManager(
 		allComponents = append(allComponents, strawberry)
 	}
 
+	allComponents = append(allComponents, components.NewRobotTokens(cfgen, ytsaurus, yc, allComponents))
+
+	// Cypress state is not a part of the cluster status, its drift is only reported as conditions.
+	cs := components.NewCypressState(cfgen, ytsaurus, yc)
+
 	// Fetch component status.
 	var readyComponents []string
 	var notReadyComponents []string
@@ -176,7 +222,9 @@ func NewComponentManager(
 		ytsaurus:              ytsaurus,
 		allComponents:         allComponents,
 		queryTrackerComponent: q,
+		queueAgentComponent:   qa,
 		schedulerComponent:    s,
+		cypressStateComponent: cs,
 		status:                status,
 	}, nil
 }
@@ -212,6 +260,27 @@ func (cm *ComponentManager) Sync(ctx context.Context) (ctrl.Result, error) {
 	return ctrl.Result{RequeueAfter: time.Second}, nil
 }
 
+// syncCypressState converges the drift of Cypress state and persists the drift conditions.
+// Cypress may be temporarily unavailable, so its errors don't affect the cluster state.
+func (cm *ComponentManager) syncCypressState(ctx context.Context) (bool, error) {
+	logger := log.FromContext(ctx)
+
+	status := cm.cypressStateComponent.Status(ctx)
+	if status.SyncStatus == components.SyncStatusPending {
+		logger.Info("component sync", "component", cm.cypressStateComponent.GetName())
+		if err := cm.cypressStateComponent.Sync(ctx); err != nil {
+			logger.Error(err, "component sync failed", "component", cm.cypressStateComponent.GetName())
+		}
+	}
+
+	if err := cm.ytsaurus.APIProxy().UpdateStatus(ctx); err != nil {
+		logger.Error(err, "update Ytsaurus status failed")
+		return false, err
+	}
+
+	return status.SyncStatus == components.SyncStatusReady, nil
+}
+
 func (cm *ComponentManager) needSync() bool {
 	return cm.status.needSync
 }
@@ -232,6 +301,10 @@ func (cm *ComponentManager) needQueryTrackerUpdate() bool {
 	return cm.queryTrackerComponent != nil && components.IsUpdatingComponent(cm.ytsaurus, cm.queryTrackerComponent)
 }
 
+func (cm *ComponentManager) needQueueAgentUpdate() bool {
+	return cm.queueAgentComponent != nil && components.IsUpdatingComponent(cm.ytsaurus, cm.queueAgentComponent)
+}
+
 func (cm *ComponentManager) needSchedulerUpdate() bool {
 	return cm.schedulerComponent != nil && components.IsUpdatingComponent(cm.ytsaurus, cm.schedulerComponent)
 }
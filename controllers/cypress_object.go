@@ -0,0 +1,131 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"go.ytsaurus.tech/yt/go/yt"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// cypressObject is a component which syncs a custom resource to an object in Cypress.
+type cypressObject interface {
+	Fetch(ctx context.Context) error
+	Sync(ctx context.Context) error
+	// Remove removes the object from Cypress when the resource is deleted.
+	Remove(ctx context.Context) error
+	// IsReady reports if the object has settled, otherwise the resource is requeued sooner.
+	IsReady() bool
+}
+
+// cypressObjectSync describes a custom resource, which is synced to an object in Cypress.
+type cypressObjectSync struct {
+	// name of the component, which is used in logs.
+	name         string
+	resource     client.Object
+	apiProxy     apiproxy.APIProxy
+	ytsaurusName string
+	newComponent func(ytsaurus *ytv1.Ytsaurus, ytClient yt.Client) (cypressObject, error)
+}
+
+// removeCypressObjectFinalizer lets the resource be deleted.
+func removeCypressObjectFinalizer(ctx context.Context, object cypressObjectSync) (ctrl.Result, error) {
+	controllerutil.RemoveFinalizer(object.resource, consts.CypressObjectFinalizer)
+	if err := object.apiProxy.Client().Update(ctx, object.resource); err != nil {
+		log.FromContext(ctx).Error(err, "failed to remove finalizer", "component", object.name)
+		return ctrl.Result{Requeue: true}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// syncCypressObject is shared by the reconcilers of resources, which are synced to objects in Cypress.
+// The objects are removed from Cypress before the resources are deleted.
+func syncCypressObject(ctx context.Context, ytClients *YtClientCache, object cypressObjectSync) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	k8sClient := object.apiProxy.Client()
+
+	deleting := !object.resource.GetDeletionTimestamp().IsZero()
+	if deleting && !controllerutil.ContainsFinalizer(object.resource, consts.CypressObjectFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	var ytsaurus ytv1.Ytsaurus
+	ytsaurusName := types.NamespacedName{Name: object.ytsaurusName, Namespace: object.resource.GetNamespace()}
+	if err := k8sClient.Get(ctx, ytsaurusName, &ytsaurus); err != nil {
+		if deleting && apierrors.IsNotFound(err) {
+			logger.Info("Ytsaurus is deleted, nothing to remove", "component", object.name)
+			return removeCypressObjectFinalizer(ctx, object)
+		}
+		logger.Error(err, "unable to fetch Ytsaurus", "component", object.name)
+		return ctrl.Result{RequeueAfter: time.Second * 10}, err
+	}
+
+	if deleting && !ytsaurus.GetDeletionTimestamp().IsZero() {
+		logger.Info("Ytsaurus is being deleted, nothing to remove", "component", object.name)
+		return removeCypressObjectFinalizer(ctx, object)
+	}
+
+	if ytsaurus.Status.State != ytv1.ClusterStateRunning {
+		logger.Info("Ytsaurus is not running, waiting")
+		return ctrl.Result{RequeueAfter: time.Second * 10}, nil
+	}
+
+	ytClient, err := ytClients.Get(ctx, k8sClient, &ytsaurus)
+	if err != nil {
+		logger.Error(err, "failed to create yt client")
+		return ctrl.Result{RequeueAfter: time.Second * 10}, err
+	}
+
+	component, err := object.newComponent(&ytsaurus, ytClient)
+	if err != nil {
+		logger.Error(err, "failed to create component", "component", object.name)
+		return ctrl.Result{RequeueAfter: time.Second * 10}, err
+	}
+
+	if err := component.Fetch(ctx); err != nil {
+		logger.Error(err, "component fetch failed", "component", object.name)
+		return ctrl.Result{Requeue: true}, err
+	}
+
+	if deleting {
+		if err := component.Remove(ctx); err != nil {
+			logger.Error(err, "component remove failed", "component", object.name)
+			object.apiProxy.RecordWarning("RemoveFailed", err.Error())
+			return ctrl.Result{RequeueAfter: time.Second * 10}, nil
+		}
+		return removeCypressObjectFinalizer(ctx, object)
+	}
+
+	if !controllerutil.ContainsFinalizer(object.resource, consts.CypressObjectFinalizer) {
+		controllerutil.AddFinalizer(object.resource, consts.CypressObjectFinalizer)
+		if err := k8sClient.Update(ctx, object.resource); err != nil {
+			logger.Error(err, "failed to add finalizer", "component", object.name)
+			return ctrl.Result{Requeue: true}, err
+		}
+	}
+
+	syncErr := component.Sync(ctx)
+	if syncErr != nil {
+		logger.Error(syncErr, "component sync failed", "component", object.name)
+	}
+
+	if err := object.apiProxy.UpdateStatus(ctx); err != nil {
+		logger.Error(err, "update status failed", "component", object.name)
+		return ctrl.Result{Requeue: true}, err
+	}
+
+	if syncErr != nil || !component.IsReady() {
+		return ctrl.Result{RequeueAfter: time.Second * 10}, nil
+	}
+
+	// Requeue periodically to converge the drift of Cypress state.
+	return ctrl.Result{RequeueAfter: time.Minute}, nil
+}
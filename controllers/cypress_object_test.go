@@ -0,0 +1,261 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/components"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	mock_yt "github.com/ytsaurus/yt-k8s-operator/pkg/mock"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
+	"go.ytsaurus.tech/yt/go/guid"
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yson"
+	"go.ytsaurus.tech/yt/go/yt"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const testClusterDomain = "cluster.local"
+
+// cypressObjectTest contains a running Ytsaurus in a fake Kubernetes cluster,
+// the reconcilers talk to it with the mock client.
+type cypressObjectTest struct {
+	g            *WithT
+	k8sClient    client.Client
+	recorder     *record.FakeRecorder
+	scheme       *runtime.Scheme
+	mockYtClient *mock_yt.MockClient
+	ytClients    *YtClientCache
+}
+
+func newCypressObjectTest(t *testing.T, objects ...client.Object) *cypressObjectTest {
+	g := NewWithT(t)
+	t.Setenv("K8S_CLUSTER_DOMAIN", testClusterDomain)
+
+	ytsaurus := &ytv1.Ytsaurus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ytsaurus",
+			Namespace: "default",
+			UID:       "ytsaurus-uid",
+		},
+		Status: ytv1.YtsaurusStatus{
+			State: ytv1.ClusterStateRunning,
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      components.GetYtsaurusClientSecretName(),
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			consts.TokenSecretKey: []byte("token"),
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	g.Expect(ytv1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(batchv1.AddToScheme(scheme)).To(Succeed())
+
+	mockYtClient := mock_yt.NewMockClient(gomock.NewController(t))
+
+	// The client is put to the cache in advance, so the reconcilers don't create a real one.
+	ytClients := NewYtClientCache()
+	ytClients.clients[ytsaurus.UID] = cachedYtClient{
+		token:    "token",
+		proxy:    ytconfig.NewGenerator(ytsaurus, testClusterDomain).GetHTTPProxiesAddress(consts.DefaultHTTPProxyRole),
+		ytClient: mockYtClient,
+	}
+
+	return &cypressObjectTest{
+		g:            g,
+		k8sClient:    fake.NewClientBuilder().WithScheme(scheme).WithObjects(append(objects, ytsaurus, secret)...).Build(),
+		recorder:     record.NewFakeRecorder(100),
+		scheme:       scheme,
+		mockYtClient: mockYtClient,
+		ytClients:    ytClients,
+	}
+}
+
+// reconcile runs the reconciler and refetches the resource, it reports false if the resource was deleted.
+func (t *cypressObjectTest) reconcile(reconciler interface {
+	Reconcile(context.Context, ctrl.Request) (ctrl.Result, error)
+}, resource client.Object) bool {
+	key := client.ObjectKeyFromObject(resource)
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	t.g.Expect(err).Should(Succeed())
+
+	err = t.k8sClient.Get(context.Background(), key, resource)
+	if apierrors.IsNotFound(err) {
+		return false
+	}
+	t.g.Expect(err).Should(Succeed())
+	return true
+}
+
+// update changes the spec of the resource.
+func (t *cypressObjectTest) update(resource client.Object, change func()) {
+	change()
+	t.g.Expect(t.k8sClient.Update(context.Background(), resource)).Should(Succeed())
+}
+
+func (t *cypressObjectTest) delete(resource client.Object) {
+	t.g.Expect(t.k8sClient.Delete(context.Background(), resource)).Should(Succeed())
+}
+
+func (t *cypressObjectTest) expectSynced(resource client.Object, conditions []metav1.Condition) {
+	t.g.Expect(controllerutil.ContainsFinalizer(resource, consts.CypressObjectFinalizer)).Should(BeTrue())
+	t.g.Expect(meta.IsStatusConditionTrue(conditions, consts.ConditionSynced)).Should(BeTrue())
+}
+
+// expectGetNode returns the value as if it was read from Cypress.
+func (t *cypressObjectTest) expectGetNode(path string, value interface{}) *gomock.Call {
+	return t.mockYtClient.EXPECT().
+		GetNode(gomock.Any(), gomock.Eq(ypath.Path(path)), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ ypath.YPath, result interface{}, _ *yt.GetNodeOptions) error {
+			data, err := yson.Marshal(value)
+			if err != nil {
+				return err
+			}
+			return yson.Unmarshal(data, result)
+		})
+}
+
+func (t *cypressObjectTest) expectNodeExists(path string, exists bool) *gomock.Call {
+	return t.mockYtClient.EXPECT().
+		NodeExists(gomock.Any(), gomock.Eq(ypath.Path(path)), gomock.Any()).
+		Return(exists, nil)
+}
+
+func (t *cypressObjectTest) expectSetNode(path string, value interface{}) *gomock.Call {
+	return t.mockYtClient.EXPECT().
+		SetNode(gomock.Any(), gomock.Eq(ypath.Path(path)), gomock.Eq(value), gomock.Any()).
+		Return(nil)
+}
+
+func (t *cypressObjectTest) expectCreateObject(typ yt.NodeType) *gomock.Call {
+	return t.mockYtClient.EXPECT().
+		CreateObject(gomock.Any(), gomock.Eq(typ), gomock.Any()).
+		Return(yt.NodeID(guid.New()), nil)
+}
+
+// expectRemoveObject expects the removal of an existing object, which is not builtin.
+func (t *cypressObjectTest) expectRemoveObject(path string) *gomock.Call {
+	t.expectNodeExists(path, true)
+	t.expectNodeExists(path+"/@builtin", false)
+	return t.mockYtClient.EXPECT().
+		RemoveNode(gomock.Any(), gomock.Eq(ypath.Path(path)), gomock.Any()).
+		Return(nil)
+}
+
+func TestCypressObjectFinalizerIsDroppedWithYtsaurus(t *testing.T) {
+	bundle := &ytv1.TabletCellBundle{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "bundle",
+			Namespace:  "default",
+			Finalizers: []string{consts.CypressObjectFinalizer},
+		},
+		Spec: ytv1.TabletCellBundleSpec{
+			Ytsaurus: corev1.LocalObjectReference{Name: "ytsaurus"},
+		},
+	}
+	test := newCypressObjectTest(t, bundle)
+	reconciler := &TabletCellBundleReconciler{
+		Client:    test.k8sClient,
+		Scheme:    test.scheme,
+		Recorder:  test.recorder,
+		YtClients: test.ytClients,
+	}
+
+	var ytsaurus ytv1.Ytsaurus
+	ytsaurusName := types.NamespacedName{Name: "ytsaurus", Namespace: "default"}
+	test.g.Expect(test.k8sClient.Get(context.Background(), ytsaurusName, &ytsaurus)).Should(Succeed())
+	test.g.Expect(test.k8sClient.Delete(context.Background(), &ytsaurus)).Should(Succeed())
+
+	// The cluster is gone, so no Cypress calls are expected.
+	test.delete(bundle)
+	test.g.Expect(test.reconcile(reconciler, bundle)).Should(BeFalse())
+}
+
+func TestTabletCellBundleSync(t *testing.T) {
+	bundle := &ytv1.TabletCellBundle{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bundle",
+			Namespace: "default",
+		},
+		Spec: ytv1.TabletCellBundleSpec{
+			Ytsaurus:         corev1.LocalObjectReference{Name: "ytsaurus"},
+			ChangelogAccount: "sys",
+			SnapshotAccount:  "sys",
+			TabletCellCount:  1,
+		},
+	}
+	test := newCypressObjectTest(t, bundle)
+	reconciler := &TabletCellBundleReconciler{
+		Client:    test.k8sClient,
+		Scheme:    test.scheme,
+		Recorder:  test.recorder,
+		YtClients: test.ytClients,
+	}
+	path := "//sys/tablet_cell_bundles/bundle"
+	expectStatus := func(tabletCellCount int) {
+		test.expectGetNode(path+"/@health", "good")
+		test.expectGetNode(path+"/@tablet_cell_count", tabletCellCount)
+	}
+
+	// The bundle is created with its tablet cells.
+	gomock.InOrder(
+		test.expectNodeExists(path, false),
+		test.expectCreateObject(yt.NodeTabletCellBundle),
+		test.expectGetNode(path+"/@tablet_cell_count", 0),
+		test.expectCreateObject("tablet_cell"),
+	)
+	expectStatus(1)
+
+	test.g.Expect(test.reconcile(reconciler, bundle)).Should(BeTrue())
+	test.expectSynced(bundle, bundle.Status.Conditions)
+	test.g.Expect(bundle.Status.Health).Should(Equal("good"))
+
+	// The changed options are updated.
+	test.update(bundle, func() {
+		bundle.Spec.SnapshotAccount = "tmp"
+	})
+	test.expectNodeExists(path, true)
+	test.expectGetNode(path+"/@options", map[string]interface{}{
+		"changelog_account": "sys",
+		"snapshot_account":  "sys",
+	})
+	test.expectSetNode(path+"/@options/snapshot_account", "tmp")
+	test.expectGetNode(path+"/@tablet_cell_count", 1)
+	expectStatus(1)
+
+	test.g.Expect(test.reconcile(reconciler, bundle)).Should(BeTrue())
+	test.expectSynced(bundle, bundle.Status.Conditions)
+
+	// The bundle is removed with its tablet cells.
+	test.delete(bundle)
+	gomock.InOrder(
+		test.expectNodeExists(path, true),
+		test.expectGetNode(path+"/@tablet_cell_ids", []string{"1-2-3-4"}),
+		test.mockYtClient.EXPECT().
+			RemoveNode(gomock.Any(), gomock.Eq(ypath.Path("#1-2-3-4")), gomock.Any()).
+			Return(nil),
+		test.expectRemoveObject(path),
+	)
+
+	test.g.Expect(test.reconcile(reconciler, bundle)).Should(BeFalse())
+}
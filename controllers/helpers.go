@@ -1,10 +1,20 @@
 package controllers
 
 import (
+	"context"
+	"fmt"
 	"net"
 	"os"
 	"strings"
+	"sync"
 
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/components"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
+	"go.ytsaurus.tech/yt/go/yt"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -30,3 +40,72 @@ func getClusterDomain(client client.Client) string {
 
 	return clusterDomain
 }
+
+// getOperatorToken reads the token of the operator's robot user.
+func getOperatorToken(ctx context.Context, c client.Client, ytsaurus *ytv1.Ytsaurus) (string, error) {
+	var secret corev1.Secret
+	secretName := types.NamespacedName{Name: components.GetYtsaurusClientSecretName(), Namespace: ytsaurus.Namespace}
+	if err := c.Get(ctx, secretName, &secret); err != nil {
+		return "", err
+	}
+
+	token, ok := secret.Data[consts.TokenSecretKey]
+	if !ok {
+		return "", fmt.Errorf("secret %s has no %s key", secretName, consts.TokenSecretKey)
+	}
+
+	return string(token), nil
+}
+
+type cachedYtClient struct {
+	token    string
+	proxy    string
+	ytClient yt.Client
+}
+
+// YtClientCache keeps clients of the clusters authorized as the operator's robot user,
+// so they are not created on every reconciliation. A client is recreated when
+// the cluster, its proxy address or the operator's token changes.
+type YtClientCache struct {
+	mutex   sync.Mutex
+	clients map[types.UID]cachedYtClient
+}
+
+func NewYtClientCache() *YtClientCache {
+	return &YtClientCache{
+		clients: make(map[types.UID]cachedYtClient),
+	}
+}
+
+func (c *YtClientCache) Get(ctx context.Context, k8sClient client.Client, ytsaurus *ytv1.Ytsaurus) (yt.Client, error) {
+	token, err := getOperatorToken(ctx, k8sClient, ytsaurus)
+	if err != nil {
+		return nil, err
+	}
+
+	cfgen := ytconfig.NewGenerator(ytsaurus, getClusterDomain(k8sClient))
+	proxy := cfgen.GetHTTPProxiesAddress(consts.DefaultHTTPProxyRole)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	cached, ok := c.clients[ytsaurus.UID]
+	if ok && cached.token == token && cached.proxy == proxy {
+		return cached.ytClient, nil
+	}
+	if ok {
+		cached.ytClient.Stop()
+	}
+
+	ytClient, err := components.NewYtClient(cfgen, token)
+	if err != nil {
+		return nil, err
+	}
+
+	c.clients[ytsaurus.UID] = cachedYtClient{
+		token:    token,
+		proxy:    proxy,
+		ytClient: ytClient,
+	}
+	return ytClient, nil
+}
@@ -0,0 +1,61 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/components"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func (r *RemoteDataNodesReconciler) Sync(ctx context.Context, resource *ytv1.RemoteDataNodes) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	nodes := apiproxy.NewRemoteDataNodes(resource, r.Client, r.Recorder, r.Scheme)
+
+	cfgen, err := ytconfig.NewRemoteNodeGenerator(
+		resource.Name,
+		&resource.Spec.CommonSpec,
+		resource.Spec.InternalTransport,
+		[]byte(resource.Spec.ClusterConnection))
+	if err != nil {
+		// The cluster connection will not become valid until the spec is changed.
+		logger.Error(err, "invalid cluster connection")
+		nodes.SetStatusCondition(metav1.Condition{
+			Type:    consts.ConditionSynced,
+			Status:  metav1.ConditionFalse,
+			Reason:  "InvalidClusterConnection",
+			Message: err.Error(),
+		})
+		return ctrl.Result{}, nodes.APIProxy().UpdateStatus(ctx)
+	}
+
+	component := components.NewRemoteDataNodes(cfgen, nodes)
+	if err := component.Fetch(ctx); err != nil {
+		logger.Error(err, "component fetch failed", "component", "remoteDataNodes")
+		return ctrl.Result{Requeue: true}, err
+	}
+
+	ready, syncErr := component.Sync(ctx)
+	if syncErr != nil {
+		logger.Error(syncErr, "component sync failed", "component", "remoteDataNodes")
+	}
+
+	if err := nodes.APIProxy().UpdateStatus(ctx); err != nil {
+		logger.Error(err, "update remote data nodes status failed")
+		return ctrl.Result{Requeue: true}, err
+	}
+
+	if syncErr != nil || !ready {
+		return ctrl.Result{RequeueAfter: time.Second * 10}, nil
+	}
+
+	// Requeue periodically to notice pods that have stopped running.
+	return ctrl.Result{RequeueAfter: time.Minute}, nil
+}
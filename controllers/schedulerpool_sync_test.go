@@ -0,0 +1,134 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"go.ytsaurus.tech/yt/go/yt"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ptr "k8s.io/utils/pointer"
+)
+
+func TestSchedulerPoolTreeSync(t *testing.T) {
+	poolTree := &ytv1.SchedulerPoolTree{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gpu",
+			Namespace: "default",
+		},
+		Spec: ytv1.SchedulerPoolTreeSpec{
+			Ytsaurus:    corev1.LocalObjectReference{Name: "ytsaurus"},
+			Default:     true,
+			NodesFilter: ptr.String("gpu"),
+		},
+	}
+	test := newCypressObjectTest(t, poolTree)
+	reconciler := &SchedulerPoolTreeReconciler{
+		Client:    test.k8sClient,
+		Scheme:    test.scheme,
+		Recorder:  test.recorder,
+		YtClients: test.ytClients,
+	}
+	path := "//sys/pool_trees/gpu"
+	defaultTreePath := "//sys/pool_trees/@default_tree"
+
+	// The tree is created and becomes the default one.
+	gomock.InOrder(
+		test.expectNodeExists(path, false),
+		test.expectCreateObject(yt.NodeSchedulerPoolTree),
+		test.expectNodeExists(defaultTreePath, true),
+		test.expectGetNode(defaultTreePath, "default"),
+		test.expectSetNode(defaultTreePath, "gpu"),
+	)
+
+	test.g.Expect(test.reconcile(reconciler, poolTree)).Should(BeTrue())
+	test.expectSynced(poolTree, poolTree.Status.Conditions)
+
+	// The changed config is updated.
+	test.update(poolTree, func() {
+		poolTree.Spec.NodesFilter = ptr.String("gpu_v2")
+	})
+	gomock.InOrder(
+		test.expectNodeExists(path, true),
+		test.expectNodeExists(path+"/@config/nodes_filter", true),
+		test.expectGetNode(path+"/@config/nodes_filter", "gpu"),
+		test.expectSetNode(path+"/@config/nodes_filter", "gpu_v2"),
+		test.expectNodeExists(defaultTreePath, true),
+		test.expectGetNode(defaultTreePath, "gpu"),
+	)
+
+	test.g.Expect(test.reconcile(reconciler, poolTree)).Should(BeTrue())
+	test.expectSynced(poolTree, poolTree.Status.Conditions)
+
+	// The default tree is switched back before the tree is removed.
+	test.delete(poolTree)
+	gomock.InOrder(
+		test.expectNodeExists(defaultTreePath, true),
+		test.expectGetNode(defaultTreePath, "gpu"),
+		test.expectSetNode(defaultTreePath, "default"),
+		test.expectRemoveObject(path),
+	)
+
+	test.g.Expect(test.reconcile(reconciler, poolTree)).Should(BeFalse())
+}
+
+func TestSchedulerPoolSync(t *testing.T) {
+	pool := &ytv1.SchedulerPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "analytics",
+			Namespace: "default",
+		},
+		Spec: ytv1.SchedulerPoolSpec{
+			Ytsaurus:          corev1.LocalObjectReference{Name: "ytsaurus"},
+			PoolTree:          "physical",
+			MaxOperationCount: ptr.Int(10),
+		},
+	}
+	test := newCypressObjectTest(t, pool)
+	reconciler := &SchedulerPoolReconciler{
+		Client:    test.k8sClient,
+		Scheme:    test.scheme,
+		Recorder:  test.recorder,
+		YtClients: test.ytClients,
+	}
+	treePath := "//sys/pool_trees/physical"
+	path := treePath + "/analytics"
+
+	// The pool is created in the root of the tree.
+	gomock.InOrder(
+		test.expectNodeExists(treePath, true),
+		test.expectGetNode(treePath, map[string]interface{}{}),
+		test.expectCreateObject(yt.NodeSchedulerPool),
+	)
+
+	test.g.Expect(test.reconcile(reconciler, pool)).Should(BeTrue())
+	test.expectSynced(pool, pool.Status.Conditions)
+	test.g.Expect(pool.Status.Path).Should(Equal(path))
+
+	// The changed attributes are updated.
+	test.update(pool, func() {
+		pool.Spec.MaxOperationCount = ptr.Int(20)
+	})
+	gomock.InOrder(
+		test.expectNodeExists(treePath, true),
+		test.expectGetNode(treePath, map[string]interface{}{"analytics": map[string]interface{}{}}),
+		test.expectNodeExists(path+"/@max_operation_count", true),
+		test.expectGetNode(path+"/@max_operation_count", 10),
+		test.expectSetNode(path+"/@max_operation_count", 20),
+	)
+
+	test.g.Expect(test.reconcile(reconciler, pool)).Should(BeTrue())
+	test.expectSynced(pool, pool.Status.Conditions)
+
+	// The pool is removed.
+	test.delete(pool)
+	gomock.InOrder(
+		test.expectNodeExists(treePath, true),
+		test.expectGetNode(treePath, map[string]interface{}{"analytics": map[string]interface{}{}}),
+		test.expectRemoveObject(path),
+	)
+
+	test.g.Expect(test.reconcile(reconciler, pool)).Should(BeFalse())
+}
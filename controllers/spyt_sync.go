@@ -26,8 +26,20 @@ func (r *SpytReconciler) Sync(ctx context.Context, resource *ytv1.Spyt, ytsaurus
 		return ctrl.Result{Requeue: true}, err
 	}
 
-	if spyt.GetResource().Status.ReleaseStatus == ytv1.SpytReleaseStatusFinished {
-		return ctrl.Result{}, nil
+	if status := spyt.GetResource().Status; status.ReleaseStatus == ytv1.SpytReleaseStatusFinished {
+		if status.ObservedImage == "" {
+			// The resource was released before the image was recorded in status.
+			spyt.GetResource().Status.ObservedImage = resource.Spec.Image
+			spyt.GetResource().Status.ObservedGeneration = resource.Generation
+			spyt.GetResource().Status.ReleasedImages = []string{resource.Spec.Image}
+			return ctrl.Result{}, spyt.APIProxy().UpdateStatus(ctx)
+		}
+
+		if status.ObservedImage == resource.Spec.Image {
+			return ctrl.Result{}, nil
+		}
+
+		logger.Info("SPYT image changed, releasing", "image", resource.Spec.Image, "previousImage", status.ObservedImage)
 	}
 
 	componentStatus := component.Status(ctx)
@@ -0,0 +1,27 @@
+package controllers
+
+import (
+	"context"
+
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/components"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
+	"go.ytsaurus.tech/yt/go/yt"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func (r *SpytClusterReconciler) Sync(ctx context.Context, resource *ytv1.SpytCluster) (ctrl.Result, error) {
+	cluster := apiproxy.NewSpytCluster(resource, r.Client, r.Recorder, r.Scheme)
+
+	return syncCypressObject(ctx, r.YtClients, cypressObjectSync{
+		name:         "spytCluster",
+		resource:     resource,
+		apiProxy:     cluster.APIProxy(),
+		ytsaurusName: resource.Spec.Ytsaurus.Name,
+		newComponent: func(ytsaurus *ytv1.Ytsaurus, ytClient yt.Client) (cypressObject, error) {
+			cfgen := ytconfig.NewGenerator(ytsaurus, getClusterDomain(r.Client))
+			return components.NewSpytCluster(cfgen, cluster, ytsaurus, ytClient), nil
+		},
+	})
+}
@@ -0,0 +1,104 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"go.ytsaurus.tech/yt/go/guid"
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestSpytClusterSync(t *testing.T) {
+	cluster := &ytv1.SpytCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "spark",
+			Namespace:  "default",
+			Generation: 1,
+		},
+		Spec: ytv1.SpytClusterSpec{
+			Ytsaurus:     corev1.LocalObjectReference{Name: "ytsaurus"},
+			Image:        "ytsaurus/spyt:latest",
+			WorkerCount:  1,
+			WorkerCores:  1,
+			WorkerMemory: resource.MustParse("1Gi"),
+		},
+	}
+	test := newCypressObjectTest(t, cluster)
+	reconciler := &SpytClusterReconciler{
+		Client:    test.k8sClient,
+		Scheme:    test.scheme,
+		Recorder:  test.recorder,
+		YtClients: test.ytClients,
+	}
+	discoveryPath := "//home/spark/discovery/spark/discovery"
+	jobName := types.NamespacedName{Name: "ytsaurus-spyt-cluster-spark-init-job-launch", Namespace: "default"}
+	operationID := yt.OperationID(guid.New())
+	expectOperation := func() {
+		test.expectNodeExists(discoveryPath+"/operation", true)
+		test.mockYtClient.EXPECT().
+			ListNode(gomock.Any(), gomock.Eq(ypath.Path(discoveryPath+"/operation")), gomock.Any(), gomock.Any()).
+			SetArg(2, []string{operationID.String()}).
+			Return(nil)
+		test.mockYtClient.EXPECT().
+			GetOperation(gomock.Any(), gomock.Eq(operationID), gomock.Any()).
+			Return(&yt.OperationStatus{ID: operationID, State: yt.StateRunning}, nil)
+		test.expectNodeExists(discoveryPath+"/spark_address", true)
+		test.mockYtClient.EXPECT().
+			ListNode(gomock.Any(), gomock.Eq(ypath.Path(discoveryPath+"/spark_address")), gomock.Any(), gomock.Any()).
+			SetArg(2, []string{"spark-master:7077"}).
+			Return(nil)
+		test.expectNodeExists(discoveryPath+"/webui", false)
+		test.expectNodeExists(discoveryPath+"/shs", false)
+	}
+
+	// The cluster is launched by the job.
+	test.g.Expect(test.reconcile(reconciler, cluster)).Should(BeTrue())
+	test.g.Expect(cluster.Finalizers).ShouldNot(BeEmpty())
+
+	var job batchv1.Job
+	test.g.Expect(test.k8sClient.Get(context.Background(), jobName, &job)).Should(Succeed())
+	job.Status.Succeeded = 1
+	test.g.Expect(test.k8sClient.Update(context.Background(), &job)).Should(Succeed())
+
+	test.g.Expect(test.reconcile(reconciler, cluster)).Should(BeTrue())
+	test.g.Expect(cluster.Status.ObservedGeneration).Should(Equal(int64(1)))
+
+	// The status is read from the discovery path.
+	expectOperation()
+
+	test.g.Expect(test.reconcile(reconciler, cluster)).Should(BeTrue())
+	test.expectSynced(cluster, cluster.Status.Conditions)
+	test.g.Expect(cluster.Status.OperationID).Should(Equal(operationID.String()))
+	test.g.Expect(cluster.Status.OperationState).Should(Equal(string(yt.StateRunning)))
+	test.g.Expect(cluster.Status.MasterAddress).Should(Equal("spark-master:7077"))
+
+	// The cluster is relaunched after the spec is changed.
+	test.update(cluster, func() {
+		cluster.Spec.WorkerCount = 2
+		cluster.Generation = 2
+	})
+	expectOperation()
+
+	test.g.Expect(test.reconcile(reconciler, cluster)).Should(BeTrue())
+	test.g.Expect(cluster.Status.Restarts).Should(Equal(int32(1)))
+	test.g.Expect(apierrors.IsNotFound(test.k8sClient.Get(context.Background(), jobName, &job))).Should(BeTrue())
+
+	// The operation is aborted before the resource is deleted.
+	test.delete(cluster)
+	expectOperation()
+	test.mockYtClient.EXPECT().
+		AbortOperation(gomock.Any(), gomock.Eq(operationID), gomock.Any()).
+		Return(nil)
+
+	test.g.Expect(test.reconcile(reconciler, cluster)).Should(BeFalse())
+}
@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"context"
+	"fmt"
 	"github.com/ytsaurus/yt-k8s-operator/pkg/components"
 	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
 	"time"
@@ -123,8 +124,8 @@ func (r *YtsaurusReconciler) handleUpdatingStateFullMode(
 	case ytv1.UpdateStateWaitingForQTStateUpdatingPrepare:
 		if !componentManager.needQueryTrackerUpdate() {
 			ytsaurus.LogUpdate(ctx, "Query tracker state update was skipped")
-			ytsaurus.LogUpdate(ctx, "Waiting for safe mode disabled")
-			err := ytsaurus.SaveUpdateState(ctx, ytv1.UpdateStateWaitingForSafeModeDisabled)
+			ytsaurus.LogUpdate(ctx, "Waiting for queue agent state prepare for updating")
+			err := ytsaurus.SaveUpdateState(ctx, ytv1.UpdateStateWaitingForQAStateUpdatingPrepare)
 			return &ctrl.Result{Requeue: true}, err
 		}
 		if ytsaurus.IsUpdateStatusConditionTrue(consts.ConditionQTStatePreparedForUpdating) {
@@ -135,6 +136,26 @@ func (r *YtsaurusReconciler) handleUpdatingStateFullMode(
 
 	case ytv1.UpdateStateWaitingForQTStateUpdate:
 		if ytsaurus.IsUpdateStatusConditionTrue(consts.ConditionQTStateUpdated) {
+			ytsaurus.LogUpdate(ctx, "Waiting for queue agent state prepare for updating")
+			err := ytsaurus.SaveUpdateState(ctx, ytv1.UpdateStateWaitingForQAStateUpdatingPrepare)
+			return &ctrl.Result{Requeue: true}, err
+		}
+
+	case ytv1.UpdateStateWaitingForQAStateUpdatingPrepare:
+		if !componentManager.needQueueAgentUpdate() {
+			ytsaurus.LogUpdate(ctx, "Queue agent state update was skipped")
+			ytsaurus.LogUpdate(ctx, "Waiting for safe mode disabled")
+			err := ytsaurus.SaveUpdateState(ctx, ytv1.UpdateStateWaitingForSafeModeDisabled)
+			return &ctrl.Result{Requeue: true}, err
+		}
+		if ytsaurus.IsUpdateStatusConditionTrue(consts.ConditionQAStatePreparedForUpdating) {
+			ytsaurus.LogUpdate(ctx, "Waiting for queue agent state updating to finish")
+			err := ytsaurus.SaveUpdateState(ctx, ytv1.UpdateStateWaitingForQAStateUpdate)
+			return &ctrl.Result{Requeue: true}, err
+		}
+
+	case ytv1.UpdateStateWaitingForQAStateUpdate:
+		if ytsaurus.IsUpdateStatusConditionTrue(consts.ConditionQAStateUpdated) {
 			ytsaurus.LogUpdate(ctx, "Waiting for safe mode disabled")
 			err := ytsaurus.SaveUpdateState(ctx, ytv1.UpdateStateWaitingForSafeModeDisabled)
 			return &ctrl.Result{Requeue: true}, err
@@ -202,8 +223,8 @@ func (r *YtsaurusReconciler) handleUpdatingStateLocalMode(
 	case ytv1.UpdateStateWaitingForQTStateUpdatingPrepare:
 		if !componentManager.needQueryTrackerUpdate() {
 			ytsaurus.LogUpdate(ctx, "Query tracker state update was skipped")
-			ytsaurus.LogUpdate(ctx, "Finishing")
-			err := ytsaurus.SaveClusterState(ctx, ytv1.ClusterStateUpdateFinishing)
+			ytsaurus.LogUpdate(ctx, "Waiting for queue agent state prepare for updating")
+			err := ytsaurus.SaveUpdateState(ctx, ytv1.UpdateStateWaitingForQAStateUpdatingPrepare)
 			return &ctrl.Result{Requeue: true}, err
 		}
 		if ytsaurus.IsUpdateStatusConditionTrue(consts.ConditionQTStatePreparedForUpdating) {
@@ -214,6 +235,26 @@ func (r *YtsaurusReconciler) handleUpdatingStateLocalMode(
 
 	case ytv1.UpdateStateWaitingForQTStateUpdate:
 		if ytsaurus.IsUpdateStatusConditionTrue(consts.ConditionQTStateUpdated) {
+			ytsaurus.LogUpdate(ctx, "Waiting for queue agent state prepare for updating")
+			err := ytsaurus.SaveUpdateState(ctx, ytv1.UpdateStateWaitingForQAStateUpdatingPrepare)
+			return &ctrl.Result{Requeue: true}, err
+		}
+
+	case ytv1.UpdateStateWaitingForQAStateUpdatingPrepare:
+		if !componentManager.needQueueAgentUpdate() {
+			ytsaurus.LogUpdate(ctx, "Queue agent state update was skipped")
+			ytsaurus.LogUpdate(ctx, "Finishing")
+			err := ytsaurus.SaveClusterState(ctx, ytv1.ClusterStateUpdateFinishing)
+			return &ctrl.Result{Requeue: true}, err
+		}
+		if ytsaurus.IsUpdateStatusConditionTrue(consts.ConditionQAStatePreparedForUpdating) {
+			ytsaurus.LogUpdate(ctx, "Waiting for queue agent state updating to finish")
+			err := ytsaurus.SaveUpdateState(ctx, ytv1.UpdateStateWaitingForQAStateUpdate)
+			return &ctrl.Result{Requeue: true}, err
+		}
+
+	case ytv1.UpdateStateWaitingForQAStateUpdate:
+		if ytsaurus.IsUpdateStatusConditionTrue(consts.ConditionQAStateUpdated) {
 			ytsaurus.LogUpdate(ctx, "Finishing")
 			err := ytsaurus.SaveClusterState(ctx, ytv1.ClusterStateUpdateFinishing)
 			return &ctrl.Result{Requeue: true}, err
@@ -223,6 +264,18 @@ func (r *YtsaurusReconciler) handleUpdatingStateLocalMode(
 	return nil, nil
 }
 
+// getNextEncryptionMode makes one step from the current mode towards the target one,
+// since components in disabled and required modes cannot talk to each other.
+func getNextEncryptionMode(current, target ytv1.BusEncryptionMode) ytv1.BusEncryptionMode {
+	if current == target {
+		return current
+	}
+	if current == ytv1.BusEncryptionModeOptional {
+		return target
+	}
+	return ytv1.BusEncryptionModeOptional
+}
+
 func getComponentNames(components []components.Component) []string {
 	if components == nil {
 		return nil
@@ -251,6 +304,8 @@ func (r *YtsaurusReconciler) Sync(ctx context.Context, resource *ytv1.Ytsaurus)
 	switch resource.Status.State {
 	case ytv1.ClusterStateCreated:
 		logger.Info("Ytsaurus is just created and needs initialization")
+		// There are no running components yet, so the encryption can be enabled at once.
+		resource.Status.InternalTransportEncryptionMode = ytsaurus.GetTargetInternalTransportEncryptionMode()
 		err := ytsaurus.SaveClusterState(ctx, ytv1.ClusterStateInitializing)
 		return ctrl.Result{Requeue: true}, err
 
@@ -264,9 +319,26 @@ func (r *YtsaurusReconciler) Sync(ctx context.Context, resource *ytv1.Ytsaurus)
 
 	case ytv1.ClusterStateRunning:
 		switch {
+		case !componentManager.needSync() && ytsaurus.GetInternalTransportEncryptionMode() != ytsaurus.GetTargetInternalTransportEncryptionMode():
+			mode := getNextEncryptionMode(ytsaurus.GetInternalTransportEncryptionMode(), ytsaurus.GetTargetInternalTransportEncryptionMode())
+			logger.Info("Switching internal transport encryption mode", "mode", mode)
+			ytsaurus.APIProxy().RecordNormal(
+				"InternalTransport",
+				fmt.Sprintf("Switching internal transport encryption mode to %s", mode))
+			err := ytsaurus.SaveInternalTransportEncryptionMode(ctx, mode)
+			return ctrl.Result{Requeue: true}, err
+
 		case !componentManager.needSync():
 			logger.Info("Ytsaurus is running and happy")
-			return ctrl.Result{}, nil
+			inSync, err := componentManager.syncCypressState(ctx)
+			if err != nil {
+				return ctrl.Result{Requeue: true}, err
+			}
+			if !inSync {
+				// Requeue until the drift of Cypress state is converged.
+				return ctrl.Result{RequeueAfter: time.Minute}, nil
+			}
+			return ctrl.Result{RequeueAfter: r.ResyncPeriod}, nil
 
 		case componentManager.needFullUpdate():
 			logger.Info("Ytsaurus needs full update")
@@ -0,0 +1,89 @@
+package controllers
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetNextEncryptionMode(t *testing.T) {
+	g := NewWithT(t)
+
+	disabled := ytv1.BusEncryptionModeDisabled
+	optional := ytv1.BusEncryptionModeOptional
+	required := ytv1.BusEncryptionModeRequired
+
+	for _, tc := range []struct {
+		current, target, next ytv1.BusEncryptionMode
+	}{
+		{disabled, disabled, disabled},
+		{disabled, optional, optional},
+		{disabled, required, optional},
+		{optional, required, required},
+		{optional, disabled, disabled},
+		{required, required, required},
+		{required, optional, optional},
+		{required, disabled, optional},
+	} {
+		g.Expect(getNextEncryptionMode(tc.current, tc.target)).Should(Equal(tc.next), "from %s to %s", tc.current, tc.target)
+	}
+
+	// Switching from disabled to required takes two steps.
+	mode := getNextEncryptionMode(disabled, required)
+	g.Expect(getNextEncryptionMode(mode, required)).Should(Equal(required))
+}
+
+func TestReferencesTLSSecret(t *testing.T) {
+	g := NewWithT(t)
+
+	newYtsaurus := func(name string) *ytv1.Ytsaurus {
+		return &ytv1.Ytsaurus{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Spec: ytv1.YtsaurusSpec{
+				HTTPProxies: []ytv1.HTTPProxiesSpec{
+					{
+						Role:      consts.DefaultHTTPProxyRole,
+						Transport: ytv1.HTTPTransportSpec{HTTPSSecret: &corev1.LocalObjectReference{Name: "https"}},
+					},
+				},
+				RPCProxies: []ytv1.RPCProxiesSpec{
+					{Transport: ytv1.RPCTransportSpec{TLSSecret: &corev1.LocalObjectReference{Name: "rpc"}}},
+				},
+			},
+		}
+	}
+	ytsaurus := newYtsaurus("first")
+	references := func(ytsaurus *ytv1.Ytsaurus, name string) bool {
+		return referencesTLSSecret(ytsaurus, ytconfig.NewGenerator(ytsaurus, testClusterDomain), name)
+	}
+
+	g.Expect(references(ytsaurus, "https")).Should(BeTrue())
+	g.Expect(references(ytsaurus, "rpc")).Should(BeTrue())
+	g.Expect(references(ytsaurus, "ms-first-bus-tls")).Should(BeFalse())
+	g.Expect(references(ytsaurus, "ca")).Should(BeFalse())
+
+	// Bus secrets are watched only if the internal transport is configured.
+	ytsaurus.Spec.InternalTransport = &ytv1.InternalTransportSpec{
+		CASecret: &corev1.LocalObjectReference{Name: "ca"},
+	}
+	g.Expect(references(ytsaurus, "ms-first-bus-tls")).Should(BeTrue())
+	g.Expect(references(ytsaurus, "hp-first-bus-tls")).Should(BeTrue())
+	g.Expect(references(ytsaurus, "ca")).Should(BeTrue())
+	g.Expect(references(ytsaurus, "other")).Should(BeFalse())
+
+	// Bus secrets of components which are not deployed and of other clusters are ignored.
+	g.Expect(references(ytsaurus, "sch-first-bus-tls")).Should(BeFalse())
+	g.Expect(references(ytsaurus, "ms-second-bus-tls")).Should(BeFalse())
+	second := newYtsaurus("second")
+	second.Spec.InternalTransport = ytsaurus.Spec.InternalTransport
+	g.Expect(references(second, "ms-first-bus-tls")).Should(BeFalse())
+	g.Expect(references(second, "ms-second-bus-tls")).Should(BeTrue())
+}
@@ -0,0 +1,25 @@
+package controllers
+
+import (
+	"context"
+
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/components"
+	"go.ytsaurus.tech/yt/go/yt"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func (r *TabletCellBundleReconciler) Sync(ctx context.Context, resource *ytv1.TabletCellBundle) (ctrl.Result, error) {
+	bundle := apiproxy.NewTabletCellBundle(resource, r.Client, r.Recorder, r.Scheme)
+
+	return syncCypressObject(ctx, r.YtClients, cypressObjectSync{
+		name:         "tabletCellBundle",
+		resource:     resource,
+		apiProxy:     bundle.APIProxy(),
+		ytsaurusName: resource.Spec.Ytsaurus.Name,
+		newComponent: func(ytsaurus *ytv1.Ytsaurus, ytClient yt.Client) (cypressObject, error) {
+			return components.NewTabletCellBundle(bundle, ytClient), nil
+		},
+	})
+}
@@ -0,0 +1,118 @@
+package controllers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"go.ytsaurus.tech/yt/go/yt"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestYtAccountSync(t *testing.T) {
+	nodeCount := int64(1000)
+	account := &ytv1.YtAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "project",
+			Namespace: "default",
+		},
+		Spec: ytv1.YtAccountSpec{
+			Ytsaurus: corev1.LocalObjectReference{Name: "ytsaurus"},
+			ResourceLimits: ytv1.AccountResources{
+				NodeCount: &nodeCount,
+			},
+		},
+	}
+	test := newCypressObjectTest(t, account)
+	reconciler := &YtAccountReconciler{
+		Client:    test.k8sClient,
+		Scheme:    test.scheme,
+		Recorder:  test.recorder,
+		YtClients: test.ytClients,
+	}
+	path := "//sys/accounts/project"
+	expectStatus := func(nodeCount int64) {
+		test.expectGetNode(path+"/@resource_usage", map[string]interface{}{"node_count": 10})
+		test.expectGetNode(path+"/@resource_limits", map[string]interface{}{"node_count": nodeCount})
+	}
+
+	// The account is created with its limits.
+	gomock.InOrder(
+		test.expectNodeExists(path, false),
+		test.expectCreateObject(yt.NodeAccount),
+		test.expectNodeExists(path+"/@resource_limits/node_count", true),
+		test.expectGetNode(path+"/@resource_limits/node_count", 0),
+		test.expectSetNode(path+"/@resource_limits/node_count", int64(1000)),
+	)
+	expectStatus(1000)
+
+	test.g.Expect(test.reconcile(reconciler, account)).Should(BeTrue())
+	test.expectSynced(account, account.Status.Conditions)
+	test.g.Expect(*account.Status.ResourceUsage.NodeCount).Should(Equal(int64(10)))
+	test.g.Expect(*account.Status.ResourceLimits.NodeCount).Should(Equal(int64(1000)))
+
+	// The changed parent and limits are updated.
+	test.update(account, func() {
+		account.Spec.ParentAccount = "projects"
+		*account.Spec.ResourceLimits.NodeCount = 2000
+	})
+	gomock.InOrder(
+		test.expectNodeExists(path, true),
+		test.expectNodeExists(path+"/@parent_name", true),
+		test.expectGetNode(path+"/@parent_name", "root"),
+		test.expectSetNode(path+"/@parent_name", "projects"),
+		test.expectNodeExists(path+"/@resource_limits/node_count", true),
+		test.expectGetNode(path+"/@resource_limits/node_count", 1000),
+		test.expectSetNode(path+"/@resource_limits/node_count", int64(2000)),
+	)
+	expectStatus(2000)
+
+	test.g.Expect(test.reconcile(reconciler, account)).Should(BeTrue())
+	test.expectSynced(account, account.Status.Conditions)
+	test.g.Expect(*account.Status.ResourceLimits.NodeCount).Should(Equal(int64(2000)))
+
+	// The account is removed.
+	test.delete(account)
+	test.expectRemoveObject(path)
+
+	test.g.Expect(test.reconcile(reconciler, account)).Should(BeFalse())
+}
+
+func TestYtAccountRemoveFailure(t *testing.T) {
+	account := &ytv1.YtAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "project",
+			Namespace:  "default",
+			Finalizers: []string{consts.CypressObjectFinalizer},
+		},
+		Spec: ytv1.YtAccountSpec{
+			Ytsaurus: corev1.LocalObjectReference{Name: "ytsaurus"},
+		},
+	}
+	test := newCypressObjectTest(t, account)
+	reconciler := &YtAccountReconciler{
+		Client:    test.k8sClient,
+		Scheme:    test.scheme,
+		Recorder:  test.recorder,
+		YtClients: test.ytClients,
+	}
+	path := "//sys/accounts/project"
+
+	// The account is kept while it is in use.
+	test.delete(account)
+	gomock.InOrder(
+		test.expectNodeExists(path, true),
+		test.expectNodeExists(path+"/@builtin", false),
+		test.mockYtClient.EXPECT().
+			RemoveNode(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(errors.New("account is in use")),
+	)
+
+	test.g.Expect(test.reconcile(reconciler, account)).Should(BeTrue())
+	test.g.Expect(account.Finalizers).Should(HaveLen(1))
+	test.g.Expect(test.recorder.Events).Should(Receive(ContainSubstring("RemoveFailed")))
+}
@@ -21,12 +21,19 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+	"time"
 
 	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
 )
 
 // YtsaurusReconciler reconciles a Ytsaurus object
@@ -34,6 +41,10 @@ type YtsaurusReconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
+	// ResyncPeriod is the period of reconciliation of running clusters,
+	// which converges Cypress state and rotates robot tokens on schedule.
+	// Zero disables the periodic reconciliation.
+	ResyncPeriod time.Duration
 }
 
 type updateState struct {
@@ -74,5 +85,57 @@ func (r *YtsaurusReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&corev1.Service{}).
 		Owns(&appsv1.Deployment{}).
 		Owns(&corev1.Secret{}).
+		// TLS secrets are not owned by the operator, but pods must be rolled when they are renewed.
+		Watches(
+			&source.Kind{Type: &corev1.Secret{}},
+			handler.EnqueueRequestsFromMapFunc(r.findYtsaurusForTLSSecret),
+		).
 		Complete(r)
 }
+
+func (r *YtsaurusReconciler) findYtsaurusForTLSSecret(secret client.Object) []reconcile.Request {
+	var ytsaurusList ytv1.YtsaurusList
+	if err := r.List(context.Background(), &ytsaurusList, client.InNamespace(secret.GetNamespace())); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, ytsaurus := range ytsaurusList.Items {
+		cfgen := ytconfig.NewGenerator(&ytsaurus, getClusterDomain(r.Client))
+		if referencesTLSSecret(&ytsaurus, cfgen, secret.GetName()) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      ytsaurus.Name,
+					Namespace: ytsaurus.Namespace,
+				},
+			})
+		}
+	}
+	return requests
+}
+
+// referencesTLSSecret checks if the secret is mounted to the pods of the cluster.
+// Bus certificate secrets are issued for every component and named after its stateful set.
+func referencesTLSSecret(ytsaurus *ytv1.Ytsaurus, cfgen *ytconfig.Generator, name string) bool {
+	if transport := ytsaurus.Spec.InternalTransport; transport != nil {
+		for _, statefulSetName := range cfgen.GetStatefulSetNames() {
+			if name == statefulSetName+consts.BusSecretNameSuffix {
+				return true
+			}
+		}
+		if transport.CASecret != nil && transport.CASecret.Name == name {
+			return true
+		}
+	}
+	for _, hp := range ytsaurus.Spec.HTTPProxies {
+		if hp.Transport.HTTPSSecret != nil && hp.Transport.HTTPSSecret.Name == name {
+			return true
+		}
+	}
+	for _, rp := range ytsaurus.Spec.RPCProxies {
+		if rp.Transport.TLSSecret != nil && rp.Transport.TLSSecret.Name == name {
+			return true
+		}
+	}
+	return false
+}
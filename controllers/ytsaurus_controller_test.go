@@ -48,9 +48,15 @@ func getYtClient(g *ytconfig.Generator) yt.Client {
 		}
 	}
 
+	adminCredentials := corev1.Secret{}
+	Expect(k8sClient.Get(ctx,
+		types.NamespacedName{Name: g.GetAdminCredentialsSecretName(), Namespace: namespace},
+		&adminCredentials),
+	).Should(Succeed())
+
 	ytClient, err := ythttp.NewClient(&yt.Config{
 		Proxy: fmt.Sprintf("%s:%v", httpProxyAddress, port),
-		Token: consts.DefaultAdminPassword,
+		Token: string(adminCredentials.Data[consts.AdminTokenSecret]),
 	})
 	Expect(err).Should(Succeed())
 
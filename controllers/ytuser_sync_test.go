@@ -0,0 +1,213 @@
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestYtUserSync(t *testing.T) {
+	user := &ytv1.YtUser{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "alice",
+			Namespace: "default",
+		},
+		Spec: ytv1.YtUserSpec{
+			Ytsaurus: corev1.LocalObjectReference{Name: "ytsaurus"},
+			ACL: []ytv1.ACESpec{
+				{
+					Path:            "//home/alice",
+					Action:          "allow",
+					Permissions:     []string{"read"},
+					InheritanceMode: "object_and_descendants",
+				},
+			},
+		},
+	}
+	test := newCypressObjectTest(t, user)
+	reconciler := &YtUserReconciler{
+		Client:    test.k8sClient,
+		Scheme:    test.scheme,
+		Recorder:  test.recorder,
+		YtClients: test.ytClients,
+	}
+	path := "//sys/users/alice"
+	ace := map[string]interface{}{
+		"action":           "allow",
+		"subjects":         []interface{}{"alice"},
+		"permissions":      []interface{}{"read"},
+		"inheritance_mode": "object_and_descendants",
+	}
+	noACL := []map[string]interface{}{}
+
+	// The user is created and gets access to its path.
+	gomock.InOrder(
+		test.expectNodeExists(path, false),
+		test.expectCreateObject(yt.NodeUser),
+		test.expectGetNode("//home/alice/@acl", noACL),
+		test.expectSetNode("//home/alice/@acl", []map[string]interface{}{ace}),
+	)
+
+	test.g.Expect(test.reconcile(reconciler, user)).Should(BeTrue())
+	test.expectSynced(user, user.Status.Conditions)
+	test.g.Expect(user.Status.ACLPaths).Should(Equal([]string{"//home/alice"}))
+
+	// The access is moved to another path.
+	test.update(user, func() {
+		user.Spec.ACL[0].Path = "//home/shared"
+	})
+	gomock.InOrder(
+		test.expectNodeExists(path, true),
+		test.expectCreateObject(yt.NodeUser),
+		test.expectNodeExists("//home/alice", true),
+		test.expectGetNode("//home/alice/@acl", []map[string]interface{}{ace}),
+		test.expectSetNode("//home/alice/@acl", noACL),
+		test.expectGetNode("//home/shared/@acl", noACL),
+		test.expectSetNode("//home/shared/@acl", []map[string]interface{}{ace}),
+	)
+
+	test.g.Expect(test.reconcile(reconciler, user)).Should(BeTrue())
+	test.expectSynced(user, user.Status.Conditions)
+	test.g.Expect(user.Status.ACLPaths).Should(Equal([]string{"//home/shared"}))
+
+	// The access is revoked before the user is removed.
+	test.delete(user)
+	gomock.InOrder(
+		test.expectNodeExists("//home/shared", true),
+		test.expectGetNode("//home/shared/@acl", []map[string]interface{}{ace}),
+		test.expectSetNode("//home/shared/@acl", noACL),
+		test.expectRemoveObject(path),
+	)
+
+	test.g.Expect(test.reconcile(reconciler, user)).Should(BeFalse())
+}
+
+func TestYtUserTokenRevocation(t *testing.T) {
+	user := &ytv1.YtUser{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "robot",
+			Namespace: "default",
+		},
+		Spec: ytv1.YtUserSpec{
+			Ytsaurus:      corev1.LocalObjectReference{Name: "ytsaurus"},
+			GenerateToken: true,
+		},
+	}
+	test := newCypressObjectTest(t, user)
+	reconciler := &YtUserReconciler{
+		Client:    test.k8sClient,
+		Scheme:    test.scheme,
+		Recorder:  test.recorder,
+		YtClients: test.ytClients,
+	}
+	path := "//sys/users/robot"
+
+	// The token is generated and registered in Cypress.
+	gomock.InOrder(
+		test.expectNodeExists(path, false),
+		test.expectCreateObject(yt.NodeUser),
+		test.mockYtClient.EXPECT().
+			CreateNode(gomock.Any(), gomock.Any(), gomock.Eq(yt.NodeMap), gomock.Any()).
+			Return(yt.NodeID{}, nil),
+		test.mockYtClient.EXPECT().
+			SetNode(gomock.Any(), gomock.Any(), gomock.Eq("robot"), gomock.Any()).
+			Return(nil),
+	)
+
+	test.g.Expect(test.reconcile(reconciler, user)).Should(BeTrue())
+	test.expectSynced(user, user.Status.Conditions)
+	test.g.Expect(user.Status.TokenSecretName).Should(Equal("yt-user-robot-secret"))
+
+	var secret corev1.Secret
+	secretName := types.NamespacedName{Name: user.Status.TokenSecretName, Namespace: "default"}
+	test.g.Expect(test.k8sClient.Get(context.Background(), secretName, &secret)).Should(Succeed())
+	token := secret.StringData[consts.TokenSecretKey]
+	test.g.Expect(token).Should(HaveLen(30))
+	tokenHash := fmt.Sprintf("%x", sha256.Sum256([]byte(token)))
+
+	// The fake client keeps the string data as is, unlike the API server.
+	secret.Data = map[string][]byte{consts.TokenSecretKey: []byte(token)}
+	test.g.Expect(test.k8sClient.Update(context.Background(), &secret)).Should(Succeed())
+
+	// The token is revoked when it is not requested anymore.
+	test.update(user, func() {
+		user.Spec.GenerateToken = false
+	})
+	gomock.InOrder(
+		test.mockYtClient.EXPECT().
+			RemoveNode(gomock.Any(), gomock.Eq(ypath.Path("//sys/cypress_tokens/"+tokenHash)), gomock.Any()).
+			Return(nil),
+		test.expectNodeExists(path, true),
+		test.expectCreateObject(yt.NodeUser),
+	)
+
+	test.g.Expect(test.reconcile(reconciler, user)).Should(BeTrue())
+	test.expectSynced(user, user.Status.Conditions)
+	test.g.Expect(user.Status.TokenSecretName).Should(BeEmpty())
+	err := test.k8sClient.Get(context.Background(), secretName, &secret)
+	test.g.Expect(apierrors.IsNotFound(err)).Should(BeTrue())
+}
+
+func TestYtGroupSync(t *testing.T) {
+	group := &ytv1.YtGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "devs",
+			Namespace: "default",
+		},
+		Spec: ytv1.YtGroupSpec{
+			Ytsaurus: corev1.LocalObjectReference{Name: "ytsaurus"},
+			Members:  []string{"alice"},
+		},
+	}
+	test := newCypressObjectTest(t, group)
+	reconciler := &YtGroupReconciler{
+		Client:    test.k8sClient,
+		Scheme:    test.scheme,
+		Recorder:  test.recorder,
+		YtClients: test.ytClients,
+	}
+	path := "//sys/groups/devs"
+
+	// The group is created with its members.
+	gomock.InOrder(
+		test.expectNodeExists(path, false),
+		test.expectCreateObject(yt.NodeGroup),
+		test.expectGetNode(path+"/@members", []string{}),
+		test.mockYtClient.EXPECT().AddMember(gomock.Any(), "devs", "alice", gomock.Any()).Return(nil),
+	)
+
+	test.g.Expect(test.reconcile(reconciler, group)).Should(BeTrue())
+	test.expectSynced(group, group.Status.Conditions)
+
+	// The members are replaced.
+	test.update(group, func() {
+		group.Spec.Members = []string{"bob"}
+	})
+	gomock.InOrder(
+		test.expectNodeExists(path, true),
+		test.expectGetNode(path+"/@members", []string{"alice"}),
+		test.mockYtClient.EXPECT().AddMember(gomock.Any(), "devs", "bob", gomock.Any()).Return(nil),
+		test.mockYtClient.EXPECT().RemoveMember(gomock.Any(), "devs", "alice", gomock.Any()).Return(nil),
+	)
+
+	test.g.Expect(test.reconcile(reconciler, group)).Should(BeTrue())
+	test.expectSynced(group, group.Status.Conditions)
+
+	// The group is removed.
+	test.delete(group)
+	test.expectRemoveObject(path)
+
+	test.g.Expect(test.reconcile(reconciler, group)).Should(BeFalse())
+}
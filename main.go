@@ -20,6 +20,7 @@ import (
 	"flag"
 	"os"
 	"strings"
+	"time"
 
 	"go.uber.org/zap/zapcore"
 
@@ -57,11 +58,17 @@ func main() {
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
+	var resyncPeriod time.Duration
+	var requireAdminCredentials bool
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.BoolVar(&requireAdminCredentials, "require-admin-credentials", false,
+		"Reject Ytsaurus clusters without admin credentials instead of generating them.")
+	flag.DurationVar(&resyncPeriod, "resync-period", 10*time.Minute,
+		"The period of reconciliation of running Ytsaurus clusters, zero disables it.")
 	opts := zap.Options{
 		Development: true,
 		TimeEncoder: zapcore.ISO8601TimeEncoder,
@@ -107,16 +114,17 @@ func main() {
 	}
 
 	if err = (&controllers.YtsaurusReconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Recorder: mgr.GetEventRecorderFor("ytsaurus-controller"),
+		Client:       mgr.GetClient(),
+		Scheme:       mgr.GetScheme(),
+		Recorder:     mgr.GetEventRecorderFor("ytsaurus-controller"),
+		ResyncPeriod: resyncPeriod,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Ytsaurus")
 		os.Exit(1)
 	}
 
 	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
-		if err = (&clusterv1.Ytsaurus{}).SetupWebhookWithManager(mgr); err != nil {
+		if err = (&clusterv1.Ytsaurus{}).SetupWebhookWithManager(mgr, requireAdminCredentials); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "Ytsaurus")
 			os.Exit(1)
 		}
@@ -151,6 +159,95 @@ func main() {
 			os.Exit(1)
 		}
 	}
+	ytClients := controllers.NewYtClientCache()
+	if err = (&controllers.TabletCellBundleReconciler{
+		Client:    mgr.GetClient(),
+		Scheme:    mgr.GetScheme(),
+		Recorder:  mgr.GetEventRecorderFor("tabletcellbundle-controller"),
+		YtClients: ytClients,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TabletCellBundle")
+		os.Exit(1)
+	}
+	if err = (&controllers.SchedulerPoolTreeReconciler{
+		Client:    mgr.GetClient(),
+		Scheme:    mgr.GetScheme(),
+		Recorder:  mgr.GetEventRecorderFor("schedulerpooltree-controller"),
+		YtClients: ytClients,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "SchedulerPoolTree")
+		os.Exit(1)
+	}
+	if err = (&controllers.SchedulerPoolReconciler{
+		Client:    mgr.GetClient(),
+		Scheme:    mgr.GetScheme(),
+		Recorder:  mgr.GetEventRecorderFor("schedulerpool-controller"),
+		YtClients: ytClients,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "SchedulerPool")
+		os.Exit(1)
+	}
+	if err = (&controllers.YtUserReconciler{
+		Client:    mgr.GetClient(),
+		Scheme:    mgr.GetScheme(),
+		Recorder:  mgr.GetEventRecorderFor("ytuser-controller"),
+		YtClients: ytClients,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "YtUser")
+		os.Exit(1)
+	}
+	if err = (&controllers.YtGroupReconciler{
+		Client:    mgr.GetClient(),
+		Scheme:    mgr.GetScheme(),
+		Recorder:  mgr.GetEventRecorderFor("ytgroup-controller"),
+		YtClients: ytClients,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "YtGroup")
+		os.Exit(1)
+	}
+	if err = (&controllers.YtAccountReconciler{
+		Client:    mgr.GetClient(),
+		Scheme:    mgr.GetScheme(),
+		Recorder:  mgr.GetEventRecorderFor("ytaccount-controller"),
+		YtClients: ytClients,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "YtAccount")
+		os.Exit(1)
+	}
+	if err = (&controllers.ChytCliqueReconciler{
+		Client:    mgr.GetClient(),
+		Scheme:    mgr.GetScheme(),
+		Recorder:  mgr.GetEventRecorderFor("chytclique-controller"),
+		YtClients: ytClients,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ChytClique")
+		os.Exit(1)
+	}
+	if err = (&controllers.SpytClusterReconciler{
+		Client:    mgr.GetClient(),
+		Scheme:    mgr.GetScheme(),
+		Recorder:  mgr.GetEventRecorderFor("spytcluster-controller"),
+		YtClients: ytClients,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "SpytCluster")
+		os.Exit(1)
+	}
+	if err = (&controllers.RemoteExecNodesReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("remoteexecnodes-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "RemoteExecNodes")
+		os.Exit(1)
+	}
+	if err = (&controllers.RemoteDataNodesReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("remotedatanodes-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "RemoteDataNodes")
+		os.Exit(1)
+	}
 	//+kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -0,0 +1,46 @@
+package apiproxy
+
+import (
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type ChytClique struct {
+	apiProxy APIProxy
+	clique   *ytv1.ChytClique
+}
+
+func NewChytClique(
+	clique *ytv1.ChytClique,
+	client client.Client,
+	recorder record.EventRecorder,
+	scheme *runtime.Scheme) *ChytClique {
+	return &ChytClique{
+		clique:   clique,
+		apiProxy: NewAPIProxy(clique, client, recorder, scheme),
+	}
+}
+
+func (c *ChytClique) GetResource() *ytv1.ChytClique {
+	return c.clique
+}
+
+func (c *ChytClique) APIProxy() APIProxy {
+	return c.apiProxy
+}
+
+func (c *ChytClique) SetStatusCondition(condition metav1.Condition) {
+	meta.SetStatusCondition(&c.clique.Status.Conditions, condition)
+}
+
+func (c *ChytClique) IsStatusConditionTrue(conditionType string) bool {
+	return meta.IsStatusConditionTrue(c.clique.Status.Conditions, conditionType)
+}
+
+func (c *ChytClique) IsStatusConditionFalse(conditionType string) bool {
+	return meta.IsStatusConditionFalse(c.clique.Status.Conditions, conditionType)
+}
@@ -0,0 +1,46 @@
+package apiproxy
+
+import (
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type RemoteDataNodes struct {
+	apiProxy APIProxy
+	nodes    *ytv1.RemoteDataNodes
+}
+
+func NewRemoteDataNodes(
+	nodes *ytv1.RemoteDataNodes,
+	client client.Client,
+	recorder record.EventRecorder,
+	scheme *runtime.Scheme) *RemoteDataNodes {
+	return &RemoteDataNodes{
+		nodes:    nodes,
+		apiProxy: NewAPIProxy(nodes, client, recorder, scheme),
+	}
+}
+
+func (c *RemoteDataNodes) GetResource() *ytv1.RemoteDataNodes {
+	return c.nodes
+}
+
+func (c *RemoteDataNodes) APIProxy() APIProxy {
+	return c.apiProxy
+}
+
+func (c *RemoteDataNodes) SetStatusCondition(condition metav1.Condition) {
+	meta.SetStatusCondition(&c.nodes.Status.Conditions, condition)
+}
+
+func (c *RemoteDataNodes) IsStatusConditionTrue(conditionType string) bool {
+	return meta.IsStatusConditionTrue(c.nodes.Status.Conditions, conditionType)
+}
+
+func (c *RemoteDataNodes) IsStatusConditionFalse(conditionType string) bool {
+	return meta.IsStatusConditionFalse(c.nodes.Status.Conditions, conditionType)
+}
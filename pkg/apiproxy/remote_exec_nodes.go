@@ -0,0 +1,46 @@
+package apiproxy
+
+import (
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type RemoteExecNodes struct {
+	apiProxy APIProxy
+	nodes    *ytv1.RemoteExecNodes
+}
+
+func NewRemoteExecNodes(
+	nodes *ytv1.RemoteExecNodes,
+	client client.Client,
+	recorder record.EventRecorder,
+	scheme *runtime.Scheme) *RemoteExecNodes {
+	return &RemoteExecNodes{
+		nodes:    nodes,
+		apiProxy: NewAPIProxy(nodes, client, recorder, scheme),
+	}
+}
+
+func (c *RemoteExecNodes) GetResource() *ytv1.RemoteExecNodes {
+	return c.nodes
+}
+
+func (c *RemoteExecNodes) APIProxy() APIProxy {
+	return c.apiProxy
+}
+
+func (c *RemoteExecNodes) SetStatusCondition(condition metav1.Condition) {
+	meta.SetStatusCondition(&c.nodes.Status.Conditions, condition)
+}
+
+func (c *RemoteExecNodes) IsStatusConditionTrue(conditionType string) bool {
+	return meta.IsStatusConditionTrue(c.nodes.Status.Conditions, conditionType)
+}
+
+func (c *RemoteExecNodes) IsStatusConditionFalse(conditionType string) bool {
+	return meta.IsStatusConditionFalse(c.nodes.Status.Conditions, conditionType)
+}
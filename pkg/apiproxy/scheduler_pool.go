@@ -0,0 +1,46 @@
+package apiproxy
+
+import (
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type SchedulerPool struct {
+	apiProxy APIProxy
+	pool     *ytv1.SchedulerPool
+}
+
+func NewSchedulerPool(
+	pool *ytv1.SchedulerPool,
+	client client.Client,
+	recorder record.EventRecorder,
+	scheme *runtime.Scheme) *SchedulerPool {
+	return &SchedulerPool{
+		pool:     pool,
+		apiProxy: NewAPIProxy(pool, client, recorder, scheme),
+	}
+}
+
+func (c *SchedulerPool) GetResource() *ytv1.SchedulerPool {
+	return c.pool
+}
+
+func (c *SchedulerPool) APIProxy() APIProxy {
+	return c.apiProxy
+}
+
+func (c *SchedulerPool) SetStatusCondition(condition metav1.Condition) {
+	meta.SetStatusCondition(&c.pool.Status.Conditions, condition)
+}
+
+func (c *SchedulerPool) IsStatusConditionTrue(conditionType string) bool {
+	return meta.IsStatusConditionTrue(c.pool.Status.Conditions, conditionType)
+}
+
+func (c *SchedulerPool) IsStatusConditionFalse(conditionType string) bool {
+	return meta.IsStatusConditionFalse(c.pool.Status.Conditions, conditionType)
+}
@@ -0,0 +1,46 @@
+package apiproxy
+
+import (
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type SchedulerPoolTree struct {
+	apiProxy APIProxy
+	poolTree *ytv1.SchedulerPoolTree
+}
+
+func NewSchedulerPoolTree(
+	poolTree *ytv1.SchedulerPoolTree,
+	client client.Client,
+	recorder record.EventRecorder,
+	scheme *runtime.Scheme) *SchedulerPoolTree {
+	return &SchedulerPoolTree{
+		poolTree: poolTree,
+		apiProxy: NewAPIProxy(poolTree, client, recorder, scheme),
+	}
+}
+
+func (c *SchedulerPoolTree) GetResource() *ytv1.SchedulerPoolTree {
+	return c.poolTree
+}
+
+func (c *SchedulerPoolTree) APIProxy() APIProxy {
+	return c.apiProxy
+}
+
+func (c *SchedulerPoolTree) SetStatusCondition(condition metav1.Condition) {
+	meta.SetStatusCondition(&c.poolTree.Status.Conditions, condition)
+}
+
+func (c *SchedulerPoolTree) IsStatusConditionTrue(conditionType string) bool {
+	return meta.IsStatusConditionTrue(c.poolTree.Status.Conditions, conditionType)
+}
+
+func (c *SchedulerPoolTree) IsStatusConditionFalse(conditionType string) bool {
+	return meta.IsStatusConditionFalse(c.poolTree.Status.Conditions, conditionType)
+}
@@ -0,0 +1,46 @@
+package apiproxy
+
+import (
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type SpytCluster struct {
+	apiProxy APIProxy
+	cluster  *ytv1.SpytCluster
+}
+
+func NewSpytCluster(
+	cluster *ytv1.SpytCluster,
+	client client.Client,
+	recorder record.EventRecorder,
+	scheme *runtime.Scheme) *SpytCluster {
+	return &SpytCluster{
+		cluster:  cluster,
+		apiProxy: NewAPIProxy(cluster, client, recorder, scheme),
+	}
+}
+
+func (c *SpytCluster) GetResource() *ytv1.SpytCluster {
+	return c.cluster
+}
+
+func (c *SpytCluster) APIProxy() APIProxy {
+	return c.apiProxy
+}
+
+func (c *SpytCluster) SetStatusCondition(condition metav1.Condition) {
+	meta.SetStatusCondition(&c.cluster.Status.Conditions, condition)
+}
+
+func (c *SpytCluster) IsStatusConditionTrue(conditionType string) bool {
+	return meta.IsStatusConditionTrue(c.cluster.Status.Conditions, conditionType)
+}
+
+func (c *SpytCluster) IsStatusConditionFalse(conditionType string) bool {
+	return meta.IsStatusConditionFalse(c.cluster.Status.Conditions, conditionType)
+}
@@ -0,0 +1,46 @@
+package apiproxy
+
+import (
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type TabletCellBundle struct {
+	apiProxy APIProxy
+	bundle   *ytv1.TabletCellBundle
+}
+
+func NewTabletCellBundle(
+	bundle *ytv1.TabletCellBundle,
+	client client.Client,
+	recorder record.EventRecorder,
+	scheme *runtime.Scheme) *TabletCellBundle {
+	return &TabletCellBundle{
+		bundle:   bundle,
+		apiProxy: NewAPIProxy(bundle, client, recorder, scheme),
+	}
+}
+
+func (c *TabletCellBundle) GetResource() *ytv1.TabletCellBundle {
+	return c.bundle
+}
+
+func (c *TabletCellBundle) APIProxy() APIProxy {
+	return c.apiProxy
+}
+
+func (c *TabletCellBundle) SetStatusCondition(condition metav1.Condition) {
+	meta.SetStatusCondition(&c.bundle.Status.Conditions, condition)
+}
+
+func (c *TabletCellBundle) IsStatusConditionTrue(conditionType string) bool {
+	return meta.IsStatusConditionTrue(c.bundle.Status.Conditions, conditionType)
+}
+
+func (c *TabletCellBundle) IsStatusConditionFalse(conditionType string) bool {
+	return meta.IsStatusConditionFalse(c.bundle.Status.Conditions, conditionType)
+}
@@ -0,0 +1,46 @@
+package apiproxy
+
+import (
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type YtAccount struct {
+	apiProxy APIProxy
+	account  *ytv1.YtAccount
+}
+
+func NewYtAccount(
+	account *ytv1.YtAccount,
+	client client.Client,
+	recorder record.EventRecorder,
+	scheme *runtime.Scheme) *YtAccount {
+	return &YtAccount{
+		account:  account,
+		apiProxy: NewAPIProxy(account, client, recorder, scheme),
+	}
+}
+
+func (c *YtAccount) GetResource() *ytv1.YtAccount {
+	return c.account
+}
+
+func (c *YtAccount) APIProxy() APIProxy {
+	return c.apiProxy
+}
+
+func (c *YtAccount) SetStatusCondition(condition metav1.Condition) {
+	meta.SetStatusCondition(&c.account.Status.Conditions, condition)
+}
+
+func (c *YtAccount) IsStatusConditionTrue(conditionType string) bool {
+	return meta.IsStatusConditionTrue(c.account.Status.Conditions, conditionType)
+}
+
+func (c *YtAccount) IsStatusConditionFalse(conditionType string) bool {
+	return meta.IsStatusConditionFalse(c.account.Status.Conditions, conditionType)
+}
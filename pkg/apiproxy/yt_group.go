@@ -0,0 +1,46 @@
+package apiproxy
+
+import (
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type YtGroup struct {
+	apiProxy APIProxy
+	group    *ytv1.YtGroup
+}
+
+func NewYtGroup(
+	group *ytv1.YtGroup,
+	client client.Client,
+	recorder record.EventRecorder,
+	scheme *runtime.Scheme) *YtGroup {
+	return &YtGroup{
+		group:    group,
+		apiProxy: NewAPIProxy(group, client, recorder, scheme),
+	}
+}
+
+func (c *YtGroup) GetResource() *ytv1.YtGroup {
+	return c.group
+}
+
+func (c *YtGroup) APIProxy() APIProxy {
+	return c.apiProxy
+}
+
+func (c *YtGroup) SetStatusCondition(condition metav1.Condition) {
+	meta.SetStatusCondition(&c.group.Status.Conditions, condition)
+}
+
+func (c *YtGroup) IsStatusConditionTrue(conditionType string) bool {
+	return meta.IsStatusConditionTrue(c.group.Status.Conditions, conditionType)
+}
+
+func (c *YtGroup) IsStatusConditionFalse(conditionType string) bool {
+	return meta.IsStatusConditionFalse(c.group.Status.Conditions, conditionType)
+}
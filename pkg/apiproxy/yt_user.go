@@ -0,0 +1,46 @@
+package apiproxy
+
+import (
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type YtUser struct {
+	apiProxy APIProxy
+	user     *ytv1.YtUser
+}
+
+func NewYtUser(
+	user *ytv1.YtUser,
+	client client.Client,
+	recorder record.EventRecorder,
+	scheme *runtime.Scheme) *YtUser {
+	return &YtUser{
+		user:     user,
+		apiProxy: NewAPIProxy(user, client, recorder, scheme),
+	}
+}
+
+func (c *YtUser) GetResource() *ytv1.YtUser {
+	return c.user
+}
+
+func (c *YtUser) APIProxy() APIProxy {
+	return c.apiProxy
+}
+
+func (c *YtUser) SetStatusCondition(condition metav1.Condition) {
+	meta.SetStatusCondition(&c.user.Status.Conditions, condition)
+}
+
+func (c *YtUser) IsStatusConditionTrue(conditionType string) bool {
+	return meta.IsStatusConditionTrue(c.user.Status.Conditions, conditionType)
+}
+
+func (c *YtUser) IsStatusConditionFalse(conditionType string) bool {
+	return meta.IsStatusConditionFalse(c.user.Status.Conditions, conditionType)
+}
@@ -94,6 +94,48 @@ func (c *Ytsaurus) SaveClusterState(ctx context.Context, clusterState ytv1.Clust
 	return nil
 }
 
+// GetInternalTransportEncryptionMode returns the bus encryption mode the components are configured with.
+func (c *Ytsaurus) GetInternalTransportEncryptionMode() ytv1.BusEncryptionMode {
+	if c.ytsaurus.Status.InternalTransportEncryptionMode == "" {
+		return ytv1.BusEncryptionModeDisabled
+	}
+	return c.ytsaurus.Status.InternalTransportEncryptionMode
+}
+
+// GetTargetInternalTransportEncryptionMode returns the bus encryption mode requested in spec.
+func (c *Ytsaurus) GetTargetInternalTransportEncryptionMode() ytv1.BusEncryptionMode {
+	spec := c.ytsaurus.Spec.InternalTransport
+	if spec == nil {
+		return ytv1.BusEncryptionModeDisabled
+	}
+	if spec.EncryptionMode == "" {
+		return ytv1.BusEncryptionModeOptional
+	}
+	return spec.EncryptionMode
+}
+
+func (c *Ytsaurus) SaveInternalTransportEncryptionMode(ctx context.Context, mode ytv1.BusEncryptionMode) error {
+	logger := log.FromContext(ctx)
+	c.ytsaurus.Status.InternalTransportEncryptionMode = mode
+	if err := c.apiProxy.UpdateStatus(ctx); err != nil {
+		logger.Error(err, "unable to update internal transport encryption mode")
+		return err
+	}
+
+	return nil
+}
+
+func (c *Ytsaurus) SaveAdminCredentialsSecret(ctx context.Context, secretName string) error {
+	logger := log.FromContext(ctx)
+	c.ytsaurus.Status.AdminCredentialsSecret = secretName
+	if err := c.apiProxy.UpdateStatus(ctx); err != nil {
+		logger.Error(err, "unable to update admin credentials secret")
+		return err
+	}
+
+	return nil
+}
+
 func (c *Ytsaurus) SaveUpdateState(ctx context.Context, updateState ytv1.UpdateState) error {
 	logger := log.FromContext(ctx)
 	c.ytsaurus.Status.UpdateStatus.State = updateState
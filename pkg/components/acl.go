@@ -0,0 +1,159 @@
+package components
+
+import (
+	"context"
+	"sort"
+
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// defaultInheritanceMode is added by YT to access control entries without the inheritance mode.
+const defaultInheritanceMode = "object_and_descendants"
+
+// normalizeACL returns the entries in the form they are stored in Cypress.
+func normalizeACL(acl []map[string]interface{}) []map[string]interface{} {
+	normalized := make([]map[string]interface{}, 0, len(acl))
+	for _, ace := range acl {
+		normalizedACE := make(map[string]interface{}, len(ace)+1)
+		for key, value := range ace {
+			normalizedACE[key] = value
+		}
+		if _, ok := normalizedACE["inheritance_mode"]; !ok {
+			normalizedACE["inheritance_mode"] = defaultInheritanceMode
+		}
+		normalized = append(normalized, normalizedACE)
+	}
+	return normalized
+}
+
+// syncACLAttribute sets the ACL attribute if its current entries differ from the expected ones.
+func syncACLAttribute(ctx context.Context, ytClient yt.Client, path ypath.Path, acl []map[string]interface{}) error {
+	exists, err := ytClient.NodeExists(ctx, path, nil)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		var current []map[string]interface{}
+		if err = ytClient.GetNode(ctx, path, &current, nil); err != nil {
+			return err
+		}
+
+		equal, err := isEqualNodeValue(normalizeACL(current), normalizeACL(acl))
+		if err != nil {
+			return err
+		}
+		if equal {
+			return nil
+		}
+	}
+
+	log.FromContext(ctx).Info("Setting ACL", "path", path.String())
+	return ytClient.SetNode(ctx, path, acl, nil)
+}
+
+func isOwnedACE(ace map[string]interface{}, subject string) bool {
+	subjects, ok := ace["subjects"].([]interface{})
+	return ok && len(subjects) == 1 && subjects[0] == subject
+}
+
+func buildACE(subject string, spec ytv1.ACESpec) map[string]interface{} {
+	permissions := make([]interface{}, 0, len(spec.Permissions))
+	for _, permission := range spec.Permissions {
+		permissions = append(permissions, permission)
+	}
+
+	return map[string]interface{}{
+		"action":           spec.Action,
+		"subjects":         []interface{}{subject},
+		"permissions":      permissions,
+		"inheritance_mode": spec.InheritanceMode,
+	}
+}
+
+// syncPathACL replaces access control entries owned by the subject on the path with the expected ones.
+func syncPathACL(ctx context.Context, ytClient yt.Client, path ypath.Path, subject string, expected []map[string]interface{}) error {
+	var acl []map[string]interface{}
+	if err := ytClient.GetNode(ctx, path.Attr("acl"), &acl, nil); err != nil {
+		return err
+	}
+
+	var current []map[string]interface{}
+	newACL := make([]map[string]interface{}, 0, len(acl)+len(expected))
+	for _, ace := range acl {
+		if isOwnedACE(ace, subject) {
+			current = append(current, ace)
+		} else {
+			newACL = append(newACL, ace)
+		}
+	}
+
+	if len(current) == len(expected) {
+		inSync := true
+		for i := range current {
+			for _, key := range []string{"action", "permissions", "inheritance_mode"} {
+				equal, err := isEqualNodeValue(current[i][key], expected[i][key])
+				if err != nil {
+					return err
+				}
+				if !equal {
+					inSync = false
+				}
+			}
+		}
+		if inSync {
+			return nil
+		}
+	}
+
+	log.FromContext(ctx).Info("Updating ACL", "path", path.String(), "subject", subject)
+	newACL = append(newACL, expected...)
+	return ytClient.SetNode(ctx, path.Attr("acl"), newACL, nil)
+}
+
+// syncSubjectACL converges access control entries of the subject on all paths
+// from the spec and on previously managed paths, and returns the managed paths.
+func syncSubjectACL(
+	ctx context.Context,
+	ytClient yt.Client,
+	subject string,
+	aces []ytv1.ACESpec,
+	managedPaths []string) ([]string, error) {
+
+	expected := make(map[string][]map[string]interface{})
+	for _, ace := range aces {
+		expected[ace.Path] = append(expected[ace.Path], buildACE(subject, ace))
+	}
+
+	for _, path := range managedPaths {
+		if _, ok := expected[path]; ok {
+			continue
+		}
+
+		exists, err := ytClient.NodeExists(ctx, ypath.Path(path), nil)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			continue
+		}
+
+		if err = syncPathACL(ctx, ytClient, ypath.Path(path), subject, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	paths := make([]string, 0, len(expected))
+	for path, entries := range expected {
+		if err := syncPathACL(ctx, ytClient, ypath.Path(path), subject, entries); err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	return paths, nil
+}
@@ -0,0 +1,65 @@
+package components
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	mock_yt "github.com/ytsaurus/yt-k8s-operator/pkg/mock"
+	"go.ytsaurus.tech/yt/go/ypath"
+)
+
+var _ = Describe("Subject ACL test", func() {
+	var mockYtClient *mock_yt.MockClient
+	aclPath := ypath.Path("//home/alice").Attr("acl")
+
+	foreignACE := map[string]interface{}{
+		"action":           "allow",
+		"subjects":         []interface{}{"alice", "bob"},
+		"permissions":      []interface{}{"read"},
+		"inheritance_mode": "object_and_descendants",
+	}
+	spec := v1.ACESpec{
+		Path:            "//home/alice",
+		Action:          "allow",
+		Permissions:     []string{"read", "write"},
+		InheritanceMode: "object_and_descendants",
+	}
+
+	BeforeEach(func() {
+		mockYtClient = mock_yt.NewMockClient(ctrl)
+	})
+
+	It("Owned entries are replaced and foreign entries are kept", func() {
+		ownedACE := buildACE("alice", v1.ACESpec{
+			Path:            "//home/alice",
+			Action:          "allow",
+			Permissions:     []string{"read"},
+			InheritanceMode: "object_only",
+		})
+
+		mockYtClient.EXPECT().
+			GetNode(gomock.Any(), gomock.Eq(aclPath), gomock.Any(), gomock.Nil()).
+			SetArg(2, []map[string]interface{}{foreignACE, ownedACE}).
+			Return(nil)
+		mockYtClient.EXPECT().
+			SetNode(gomock.Any(), gomock.Eq(aclPath), gomock.Eq([]map[string]interface{}{foreignACE, buildACE("alice", spec)}), gomock.Nil()).
+			Return(nil)
+
+		paths, err := syncSubjectACL(context.Background(), mockYtClient, "alice", []v1.ACESpec{spec}, nil)
+		Expect(err).Should(Succeed())
+		Expect(paths).Should(Equal([]string{"//home/alice"}))
+	})
+
+	It("ACL in sync is not updated", func() {
+		mockYtClient.EXPECT().
+			GetNode(gomock.Any(), gomock.Eq(aclPath), gomock.Any(), gomock.Nil()).
+			SetArg(2, []map[string]interface{}{foreignACE, buildACE("alice", spec)}).
+			Return(nil)
+
+		_, err := syncSubjectACL(context.Background(), mockYtClient, "alice", []v1.ACESpec{spec}, []string{"//home/alice"})
+		Expect(err).Should(Succeed())
+	})
+})
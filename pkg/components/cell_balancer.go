@@ -0,0 +1,140 @@
+package components
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/labeller"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/resources"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
+)
+
+type cellBalancer struct {
+	componentBase
+	server          server
+	master          Component
+	initEnvironment *InitJob
+}
+
+func NewCellBalancer(cfgen *ytconfig.Generator, ytsaurus *apiproxy.Ytsaurus, master Component) Component {
+	resource := ytsaurus.GetResource()
+	l := labeller.Labeller{
+		ObjectMeta:     &resource.ObjectMeta,
+		APIProxy:       ytsaurus.APIProxy(),
+		ComponentLabel: consts.YTComponentLabelCellBalancer,
+		ComponentName:  "CellBalancer",
+		MonitoringPort: consts.CellBalancerMonitoringPort,
+	}
+
+	server := newServer(
+		&l,
+		ytsaurus,
+		&resource.Spec.CellBalancers.InstanceSpec,
+		"/usr/bin/ytserver-cell-balancer",
+		"ytserver-cell-balancer.yson",
+		cfgen.GetCellBalancerStatefulSetName(),
+		cfgen.GetCellBalancerServiceName(),
+		cfgen.GetCellBalancerConfig,
+	)
+
+	return &cellBalancer{
+		componentBase: componentBase{
+			labeller: &l,
+			ytsaurus: ytsaurus,
+			cfgen:    cfgen,
+		},
+		server: server,
+		master: master,
+		initEnvironment: NewInitJob(
+			&l,
+			ytsaurus.APIProxy(),
+			ytsaurus,
+			resource.Spec.ImagePullSecrets,
+			"cell-balancer-environment",
+			consts.ClientConfigFileName,
+			resource.Spec.CoreImage,
+			cfgen.GetNativeClientConfig),
+	}
+}
+
+func (cb *cellBalancer) IsUpdatable() bool {
+	return true
+}
+
+func (cb *cellBalancer) Fetch(ctx context.Context) error {
+	return resources.Fetch(ctx, []resources.Fetchable{
+		cb.server,
+		cb.initEnvironment,
+	})
+}
+
+func (cb *cellBalancer) createInitScript() string {
+	script := []string{
+		initJobWithNativeDriverPrologue(),
+	}
+	script = append(script, createUserCommand(consts.CellBalancerUserName, "", "", true)...)
+	script = append(script,
+		fmt.Sprintf("/usr/bin/yt create map_node %s --ignore-existing", ytconfig.CellBalancerRootPath),
+		fmt.Sprintf("/usr/bin/yt create map_node %s --recursive --ignore-existing", ytconfig.BundleControllerRootPath),
+	)
+
+	return strings.Join(script, "\n")
+}
+
+func (cb *cellBalancer) doSync(ctx context.Context, dry bool) (ComponentStatus, error) {
+	var err error
+
+	if cb.ytsaurus.GetClusterState() == ytv1.ClusterStateRunning && cb.server.needUpdate() {
+		return SimpleStatus(SyncStatusNeedLocalUpdate), err
+	}
+
+	if cb.ytsaurus.GetClusterState() == ytv1.ClusterStateUpdating {
+		if status, err := handleUpdatingClusterState(ctx, cb.ytsaurus, cb, &cb.componentBase, cb.server, dry); status != nil {
+			return *status, err
+		}
+	}
+
+	if cb.master.Status(ctx).SyncStatus != SyncStatusReady {
+		return WaitingStatus(SyncStatusBlocked, cb.master.GetName()), err
+	}
+
+	// Users and Cypress state must exist before the balancer starts to elect a leader.
+	if !dry {
+		cb.initEnvironment.SetInitScript(cb.createInitScript())
+	}
+	status, err := cb.initEnvironment.Sync(ctx, dry)
+	if err != nil || status.SyncStatus != SyncStatusReady {
+		return status, err
+	}
+
+	if cb.server.needSync() {
+		if !dry {
+			err = cb.server.Sync(ctx)
+		}
+		return WaitingStatus(SyncStatusPending, "components"), err
+	}
+
+	if !cb.server.arePodsReady(ctx) {
+		return WaitingStatus(SyncStatusBlocked, "pods"), err
+	}
+
+	return SimpleStatus(SyncStatusReady), err
+}
+
+func (cb *cellBalancer) Status(ctx context.Context) ComponentStatus {
+	status, err := cb.doSync(ctx, true)
+	if err != nil {
+		panic(err)
+	}
+
+	return status
+}
+
+func (cb *cellBalancer) Sync(ctx context.Context) error {
+	_, err := cb.doSync(ctx, false)
+	return err
+}
@@ -0,0 +1,64 @@
+package components
+
+import (
+	"context"
+
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/labeller"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/resources"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+// tlsCertificate requests the certificate of a TLS secret from cert-manager.
+// The certificate is removed if it is not requested anymore.
+type tlsCertificate struct {
+	// spec is nil if the certificate is not requested.
+	spec     *ytv1.CertificateSpec
+	labeller *labeller.Labeller
+
+	certificate *resources.Certificate
+}
+
+func newTLSCertificate(
+	secretName string,
+	spec *ytv1.CertificateSpec,
+	dnsNames []string,
+	l *labeller.Labeller) *tlsCertificate {
+	// The certificate is only fetched to be removed if it is not requested.
+	certificateSpec := spec
+	if certificateSpec == nil {
+		certificateSpec = &ytv1.CertificateSpec{}
+	}
+	return &tlsCertificate{
+		spec:        spec,
+		labeller:    l,
+		certificate: resources.NewCertificate(secretName, certificateSpec, dnsNames, l, l.APIProxy),
+	}
+}
+
+func (c *tlsCertificate) Name() string {
+	return c.certificate.Name()
+}
+
+func (c *tlsCertificate) Fetch(ctx context.Context) error {
+	err := c.certificate.Fetch(ctx)
+	// cert-manager may be missing in the cluster, then there is nothing to remove.
+	if err != nil && c.spec == nil && meta.IsNoMatchError(err) {
+		return nil
+	}
+	return err
+}
+
+func (c *tlsCertificate) needSync() bool {
+	if c.spec == nil {
+		return needRemoval(c.certificate, c.labeller)
+	}
+	return c.certificate.NeedSync()
+}
+
+func (c *tlsCertificate) Sync(ctx context.Context) error {
+	if c.spec == nil {
+		return removeObject(ctx, c.certificate, c.labeller)
+	}
+	return c.certificate.Sync(ctx)
+}
@@ -22,9 +22,10 @@ type Chyt struct {
 
 	secret *resources.StringSecret
 
-	initUser        *InitJob
-	initEnvironment *InitJob
-	initChPublicJob *InitJob
+	initUser          *InitJob
+	initEnvironment   *InitJob
+	initChPublicJob   *InitJob
+	restartCliquesJob *InitJob
 }
 
 func NewChyt(
@@ -39,7 +40,13 @@ func NewChyt(
 		ComponentName:  fmt.Sprintf("CHYT-%s", chyt.GetResource().Name),
 	}
 
-	return &Chyt{
+	// Release jobs keep the image they were started with, a newer image is released after them.
+	image := chyt.GetResource().Status.ReleasingImage
+	if image == "" {
+		image = chyt.GetResource().Spec.Image
+	}
+
+	c := &Chyt{
 		labeller: &l,
 		chyt:     chyt,
 		cfgen:    cfgen,
@@ -60,7 +67,7 @@ func NewChyt(
 			ytsaurus.Spec.ImagePullSecrets,
 			"release",
 			consts.ClientConfigFileName,
-			chyt.GetResource().Spec.Image,
+			image,
 			cfgen.GetNativeClientConfig),
 		initChPublicJob: NewInitJob(
 			&l,
@@ -69,13 +76,26 @@ func NewChyt(
 			ytsaurus.Spec.ImagePullSecrets,
 			"ch-public",
 			consts.ClientConfigFileName,
-			chyt.GetResource().Spec.Image,
+			image,
+			cfgen.GetNativeClientConfig),
+		restartCliquesJob: NewInitJob(
+			&l,
+			chyt.APIProxy(),
+			chyt,
+			ytsaurus.Spec.ImagePullSecrets,
+			"restart-cliques",
+			consts.ClientConfigFileName,
+			image,
 			cfgen.GetNativeClientConfig),
 		secret: resources.NewStringSecret(
 			l.GetSecretName(),
 			&l,
 			chyt.APIProxy()),
 	}
+	for _, job := range []*InitJob{c.initUser, c.initEnvironment, c.initChPublicJob, c.restartCliquesJob} {
+		job.SetClusterName(ytsaurus.Name)
+	}
+	return c
 }
 
 func (c *Chyt) createInitUserScript() string {
@@ -108,12 +128,39 @@ func (c *Chyt) createInitChPublicScript() string {
 		"yt clickhouse ctl set-option --alias ch_public instance_cpu 1",
 		"yt clickhouse ctl set-option --alias ch_public instance_memory '{reader=100000000;chunk_meta_cache=100000000;compressed_cache=100000000;clickhouse=100000000;clickhouse_watermark=10;footprint=500000000;log_tailer=100000000;watchdog_oom_watermark=0;watchdog_oom_window_watermark=0}'",
 		"yt clickhouse ctl set-option --alias ch_public instance_count 1",
+		"yt clickhouse ctl stop ch_public || true",
 		"yt clickhouse ctl start ch_public --untracked",
 	}
 
 	return strings.Join(script, "\n")
 }
 
+// createRestartCliquesScript restarts running cliques one by one, so they switch to the new default version.
+// The next clique is restarted only after the previous one becomes healthy.
+func (c *Chyt) createRestartCliquesScript() string {
+	script := []string{
+		initJobPrologue,
+		fmt.Sprintf("export YT_PROXY=%v CHYT_CTL_ADDRESS=%v", c.cfgen.GetHTTPProxiesAddress(consts.DefaultHTTPProxyRole), c.cfgen.GetStrawberryControllerServiceAddress()),
+		"for alias in $(yt list //sys/strawberry/chyt); do",
+		// Clique ch_public is restarted by its own job.
+		"  if [ \"$alias\" = \"ch_public\" ]; then continue; fi",
+		"  if [ \"$(yt get --format json //sys/strawberry/chyt/$alias/speclet/active 2>/dev/null)\" != \"true\" ]; then continue; fi",
+		"  yt clickhouse ctl stop $alias",
+		"  if [ \"$(yt exists //sys/strawberry/chyt/$alias/speclet/pool)\" = \"true\" ]; then",
+		"    yt clickhouse ctl start $alias",
+		"  else",
+		"    yt clickhouse ctl start $alias --untracked",
+		"  fi",
+		"  for attempt in $(seq 60); do",
+		"    if yt clickhouse ctl status $alias | grep -q '\"health\" = \"good\"'; then break; fi",
+		"    sleep 10",
+		"  done",
+		"done",
+	}
+
+	return strings.Join(script, "\n")
+}
+
 func (c *Chyt) prepareChPublicJob() {
 	c.initChPublicJob.SetInitScript(c.createInitChPublicScript())
 
@@ -122,6 +169,73 @@ func (c *Chyt) prepareChPublicJob() {
 	container.EnvFrom = []corev1.EnvFromSource{c.secret.GetEnvSource()}
 }
 
+func (c *Chyt) prepareRestartCliquesJob() {
+	c.restartCliquesJob.SetInitScript(c.createRestartCliquesScript())
+
+	job := c.restartCliquesJob.Build()
+	container := &job.Spec.Template.Spec.Containers[0]
+	container.EnvFrom = []corev1.EnvFromSource{c.secret.GetEnvSource()}
+}
+
+func (c *Chyt) needChPublic() bool {
+	return c.ytsaurus.Spec.StrawberryController != nil && c.chyt.GetResource().Spec.MakeDefault
+}
+
+// needCliquesRestart reports that running cliques use the default version of a previous release.
+func (c *Chyt) needCliquesRestart() bool {
+	return c.ytsaurus.Spec.StrawberryController != nil &&
+		c.chyt.GetResource().Spec.MakeDefault &&
+		len(c.chyt.GetResource().Status.ReleasedImages) != 0
+}
+
+// needRelease reports that the image in the spec is not released and no release is running.
+func (c *Chyt) needRelease() bool {
+	status := c.chyt.GetResource().Status
+	return status.ReleasingImage == "" && status.ObservedImage != c.chyt.GetResource().Spec.Image
+}
+
+func (c *Chyt) prepareRelease(ctx context.Context) error {
+	resource := c.chyt.GetResource()
+	if resource.Status.ObservedImage == "" {
+		c.chyt.APIProxy().RecordNormal("Releasing", fmt.Sprintf("Releasing %s", resource.Spec.Image))
+	} else {
+		c.chyt.APIProxy().RecordNormal(
+			"Releasing",
+			fmt.Sprintf("Releasing %s, previous release %s is kept", resource.Spec.Image, resource.Status.ObservedImage))
+	}
+
+	jobs := []*InitJob{c.initEnvironment}
+	if c.needChPublic() {
+		// Clique ch_public is restarted with the new version only after the upload succeeds.
+		jobs = append(jobs, c.initChPublicJob)
+	}
+	if c.needCliquesRestart() {
+		jobs = append(jobs, c.restartCliquesJob)
+	}
+	for _, job := range jobs {
+		if err := job.prepareRestart(ctx, false); err != nil {
+			return err
+		}
+	}
+
+	resource.Status.ReleasingImage = resource.Spec.Image
+	return nil
+}
+
+// finishRelease records the image of the completed release jobs.
+// If the image in the spec was changed during the release, it is released on the next sync.
+func (c *Chyt) finishRelease() {
+	resource := c.chyt.GetResource()
+	if resource.Status.ReleasingImage == "" {
+		return
+	}
+
+	resource.Status.ObservedImage = resource.Status.ReleasingImage
+	resource.Status.ObservedGeneration = resource.Generation
+	resource.Status.ReleasedImages = appendReleasedImage(resource.Status.ReleasedImages, resource.Status.ReleasingImage)
+	resource.Status.ReleasingImage = ""
+}
+
 func (c *Chyt) doSync(ctx context.Context, dry bool) (ComponentStatus, error) {
 	var err error
 
@@ -152,6 +266,16 @@ func (c *Chyt) doSync(ctx context.Context, dry bool) (ComponentStatus, error) {
 		return status, err
 	}
 
+	resource := c.chyt.GetResource()
+	if c.needRelease() {
+		// Release jobs are re-run with the new image, older releases are kept in Cypress.
+		if !dry {
+			err = c.prepareRelease(ctx)
+		}
+		resource.Status.ReleaseStatus = ytv1.ChytReleaseStatusUploadingIntoCypress
+		return WaitingStatus(SyncStatusPending, fmt.Sprintf("release of %s", resource.Spec.Image)), err
+	}
+
 	if !dry {
 		c.initEnvironment.SetInitScript(c.createInitScript())
 		job := c.initEnvironment.Build()
@@ -175,7 +299,7 @@ func (c *Chyt) doSync(ctx context.Context, dry bool) (ComponentStatus, error) {
 		return status, err
 	}
 
-	if c.ytsaurus.Spec.StrawberryController != nil && c.chyt.GetResource().Spec.MakeDefault {
+	if c.needChPublic() {
 		if !dry {
 			c.prepareChPublicJob()
 		}
@@ -186,7 +310,19 @@ func (c *Chyt) doSync(ctx context.Context, dry bool) (ComponentStatus, error) {
 		}
 	}
 
-	c.chyt.GetResource().Status.ReleaseStatus = ytv1.ChytReleaseStatusFinished
+	if c.needCliquesRestart() {
+		if !dry {
+			c.prepareRestartCliquesJob()
+		}
+		status, err = c.restartCliquesJob.Sync(ctx, dry)
+		if err != nil || status.SyncStatus != SyncStatusReady {
+			c.chyt.GetResource().Status.ReleaseStatus = ytv1.ChytReleaseStatusRestartingCliques
+			return status, err
+		}
+	}
+
+	resource.Status.ReleaseStatus = ytv1.ChytReleaseStatusFinished
+	c.finishRelease()
 
 	return SimpleStatus(SyncStatusReady), err
 }
@@ -196,6 +332,7 @@ func (c *Chyt) Fetch(ctx context.Context) error {
 		c.initUser,
 		c.initEnvironment,
 		c.initChPublicJob,
+		c.restartCliquesJob,
 		c.secret,
 	})
 }
@@ -0,0 +1,216 @@
+package components
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	chytCliqueStateActive   = "active"
+	chytCliqueStateInactive = "inactive"
+)
+
+type chytCliqueStatus struct {
+	State  string `json:"state"`
+	Health string `json:"health"`
+}
+
+type ChytClique struct {
+	clique           *apiproxy.ChytClique
+	ytClient         yt.Client
+	strawberryClient *StrawberryClient
+}
+
+func NewChytClique(clique *apiproxy.ChytClique, ytClient yt.Client, strawberryClient *StrawberryClient) *ChytClique {
+	return &ChytClique{
+		clique:           clique,
+		ytClient:         ytClient,
+		strawberryClient: strawberryClient,
+	}
+}
+
+func (c *ChytClique) getAlias() string {
+	resource := c.clique.GetResource()
+	if resource.Spec.Alias != "" {
+		return resource.Spec.Alias
+	}
+	return resource.Name
+}
+
+func (c *ChytClique) getPrincipalPath() ypath.Path {
+	return ypath.Path(fmt.Sprintf("//sys/access_control_object_namespaces/chyt/%s/principal", c.getAlias()))
+}
+
+func (c *ChytClique) getOptions() map[string]interface{} {
+	spec := c.clique.GetResource().Spec
+	options := map[string]interface{}{
+		"instance_count": spec.InstanceCount,
+		"instance_cpu":   spec.InstanceCPU,
+	}
+	if spec.InstanceMemory != nil {
+		options["instance_total_memory"] = spec.InstanceMemory.Value()
+	}
+	if spec.Pool != "" {
+		options["pool"] = spec.Pool
+	}
+	return options
+}
+
+func (c *ChytClique) call(ctx context.Context, command string, params map[string]interface{}, result interface{}) error {
+	params["alias"] = c.getAlias()
+	return c.strawberryClient.Call(ctx, command, params, result)
+}
+
+func (c *ChytClique) syncOptions(ctx context.Context) error {
+	logger := log.FromContext(ctx)
+
+	var speclet map[string]interface{}
+	if err := c.call(ctx, "get_speclet", map[string]interface{}{}, &speclet); err != nil {
+		return err
+	}
+
+	for key, value := range c.getOptions() {
+		if current, ok := speclet[key]; ok {
+			// Numbers are decoded as float64, so compare normalized values.
+			equal, err := isEqualNodeValue(current, value)
+			if err != nil {
+				return err
+			}
+			if equal {
+				continue
+			}
+		}
+
+		logger.Info("Setting clique option", "alias", c.getAlias(), "option", key, "value", value)
+		err := c.call(ctx, "set_option", map[string]interface{}{"key": key, "value": value}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *ChytClique) syncACL(ctx context.Context) error {
+	spec := c.clique.GetResource().Spec
+	if len(spec.ACL) == 0 {
+		return nil
+	}
+
+	acl := make([]map[string]interface{}, 0, len(spec.ACL))
+	for _, ace := range spec.ACL {
+		acl = append(acl, map[string]interface{}{
+			"action":      ace.Action,
+			"subjects":    ace.Subjects,
+			"permissions": ace.Permissions,
+		})
+	}
+
+	return syncACLAttribute(ctx, c.ytClient, c.getPrincipalPath().Attr("principal_acl"), acl)
+}
+
+func (c *ChytClique) getStatus(ctx context.Context) (chytCliqueStatus, error) {
+	var status chytCliqueStatus
+	err := c.call(ctx, "status", map[string]interface{}{}, &status)
+	return status, err
+}
+
+func (c *ChytClique) syncState(ctx context.Context) error {
+	spec := c.clique.GetResource().Spec
+
+	status, err := c.getStatus(ctx)
+	if err != nil {
+		return err
+	}
+
+	if spec.Stopped && status.State != chytCliqueStateInactive {
+		if err = c.call(ctx, "stop", map[string]interface{}{}, nil); err != nil {
+			return err
+		}
+		c.clique.APIProxy().RecordNormal("Stopped", fmt.Sprintf("Clique %s was stopped", c.getAlias()))
+	} else if !spec.Stopped && status.State == chytCliqueStateInactive {
+		// The controller tracks only cliques with a pool, others are run on behalf of the operator.
+		params := map[string]interface{}{"untracked": spec.Pool == ""}
+		if err = c.call(ctx, "start", params, nil); err != nil {
+			return err
+		}
+		c.clique.APIProxy().RecordNormal("Started", fmt.Sprintf("Clique %s was started", c.getAlias()))
+	}
+
+	if status, err = c.getStatus(ctx); err != nil {
+		return err
+	}
+	c.clique.GetResource().Status.State = status.State
+	c.clique.GetResource().Status.Health = status.Health
+	return nil
+}
+
+func (c *ChytClique) doSync(ctx context.Context) error {
+	if c.strawberryClient == nil {
+		return fmt.Errorf("strawberry controller is not configured in Ytsaurus")
+	}
+
+	var exists bool
+	if err := c.call(ctx, "exists", map[string]interface{}{}, &exists); err != nil {
+		return err
+	}
+
+	if !exists {
+		if err := c.call(ctx, "create", map[string]interface{}{}, nil); err != nil {
+			return err
+		}
+		c.clique.APIProxy().RecordNormal("Created", fmt.Sprintf("Clique %s was created", c.getAlias()))
+	}
+
+	if err := c.syncOptions(ctx); err != nil {
+		return err
+	}
+
+	if err := c.syncACL(ctx); err != nil {
+		return err
+	}
+
+	return c.syncState(ctx)
+}
+
+// Remove removes the clique via the strawberry controller, which stops its operation.
+func (c *ChytClique) Remove(ctx context.Context) error {
+	if c.strawberryClient == nil {
+		return nil
+	}
+
+	var exists bool
+	if err := c.call(ctx, "exists", map[string]interface{}{}, &exists); err != nil || !exists {
+		return err
+	}
+
+	if err := c.call(ctx, "remove", map[string]interface{}{}, nil); err != nil {
+		return err
+	}
+	c.clique.APIProxy().RecordNormal("Removed", fmt.Sprintf("Clique %s was removed", c.getAlias()))
+	return nil
+}
+
+func (c *ChytClique) IsReady() bool {
+	status := c.clique.GetResource().Status
+	return status.State != chytCliqueStateActive || status.Health == "good"
+}
+
+func (c *ChytClique) Fetch(ctx context.Context) error {
+	return nil
+}
+
+func (c *ChytClique) Sync(ctx context.Context) error {
+	err := c.doSync(ctx)
+	setSyncedCondition(c.clique, err)
+	if err == nil {
+		resource := c.clique.GetResource()
+		resource.Status.ObservedGeneration = resource.Generation
+	}
+	return err
+}
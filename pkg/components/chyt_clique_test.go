@@ -0,0 +1,195 @@
+package components
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	mock_yt "github.com/ytsaurus/yt-k8s-operator/pkg/mock"
+	"go.ytsaurus.tech/yt/go/ypath"
+	corev1 "k8s.io/api/core/v1"
+	k8sresource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// fakeStrawberryController keeps the state of a single clique.
+type fakeStrawberryController struct {
+	exists   bool
+	speclet  map[string]interface{}
+	state    string
+	commands []string
+}
+
+func (c *fakeStrawberryController) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		Params map[string]interface{} `json:"params"`
+	}
+	Expect(json.NewDecoder(r.Body).Decode(&request)).To(Succeed())
+	Expect(request.Params["alias"]).Should(Equal("clique"))
+
+	command := path.Base(r.URL.Path)
+	c.commands = append(c.commands, command)
+
+	var result interface{}
+	switch command {
+	case "exists":
+		result = c.exists
+	case "create":
+		c.exists = true
+		c.speclet = map[string]interface{}{}
+		c.state = chytCliqueStateInactive
+	case "remove":
+		c.exists = false
+	case "get_speclet":
+		result = c.speclet
+	case "set_option":
+		c.speclet[request.Params["key"].(string)] = request.Params["value"]
+	case "start":
+		c.state = chytCliqueStateActive
+	case "stop":
+		c.state = chytCliqueStateInactive
+	case "status":
+		result = chytCliqueStatus{State: c.state, Health: "good"}
+	}
+
+	Expect(json.NewEncoder(w).Encode(map[string]interface{}{"result": result})).To(Succeed())
+}
+
+var _ = Describe("Chyt clique test", func() {
+	var resource *v1.ChytClique
+	var clique *apiproxy.ChytClique
+	var controller *fakeStrawberryController
+	var server *httptest.Server
+	var strawberryClient *StrawberryClient
+
+	BeforeEach(func() {
+		resource = &v1.ChytClique{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "clique",
+				Namespace: "default",
+			},
+			Spec: v1.ChytCliqueSpec{
+				Ytsaurus:      corev1.LocalObjectReference{Name: "ytsaurus"},
+				InstanceCount: 2,
+				InstanceCPU:   4,
+			},
+		}
+
+		scheme := runtime.NewScheme()
+		Expect(v1.AddToScheme(scheme)).To(Succeed())
+		k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(resource).Build()
+		clique = apiproxy.NewChytClique(resource, k8sClient, record.NewFakeRecorder(10), scheme)
+
+		controller = &fakeStrawberryController{}
+		server = httptest.NewServer(controller)
+		strawberryClient = &StrawberryClient{
+			address:    server.URL,
+			cluster:    "cluster",
+			family:     "chyt",
+			token:      "token",
+			httpClient: server.Client(),
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("Clique is created, updated and removed", func() {
+		c := NewChytClique(clique, mock_yt.NewMockClient(ctrl), strawberryClient)
+
+		Expect(c.Sync(context.Background())).Should(Succeed())
+		Expect(controller.commands).Should(Equal([]string{
+			"exists", "create", "get_speclet", "set_option", "set_option", "status", "start", "status",
+		}))
+		Expect(controller.speclet).Should(Equal(map[string]interface{}{"instance_count": 2.0, "instance_cpu": 4.0}))
+		Expect(resource.Status.State).Should(Equal(chytCliqueStateActive))
+		Expect(c.IsReady()).Should(BeTrue())
+
+		// Only the changed options are set.
+		controller.commands = nil
+		resource.Spec.InstanceCount = 3
+		resource.Spec.Stopped = true
+		Expect(c.Sync(context.Background())).Should(Succeed())
+		Expect(controller.commands).Should(Equal([]string{
+			"exists", "get_speclet", "set_option", "status", "stop", "status",
+		}))
+		Expect(controller.speclet["instance_count"]).Should(Equal(3.0))
+		Expect(resource.Status.State).Should(Equal(chytCliqueStateInactive))
+
+		controller.commands = nil
+		Expect(c.Remove(context.Background())).Should(Succeed())
+		Expect(controller.commands).Should(Equal([]string{"exists", "remove"}))
+		Expect(controller.exists).Should(BeFalse())
+
+		// The removed clique is not removed again.
+		controller.commands = nil
+		Expect(c.Remove(context.Background())).Should(Succeed())
+		Expect(controller.commands).Should(Equal([]string{"exists"}))
+	})
+
+	It("Memory option is set once", func() {
+		memory := k8sresource.MustParse("4Gi")
+		resource.Spec.InstanceMemory = &memory
+		c := NewChytClique(clique, mock_yt.NewMockClient(ctrl), strawberryClient)
+
+		Expect(c.Sync(context.Background())).Should(Succeed())
+		Expect(controller.speclet["instance_total_memory"]).Should(Equal(4294967296.0))
+
+		// The option is read back as float64, which is equal to the memory size.
+		controller.commands = nil
+		Expect(c.Sync(context.Background())).Should(Succeed())
+		Expect(controller.commands).Should(Equal([]string{"exists", "get_speclet", "status", "status"}))
+	})
+
+	It("Clique ACL is set once", func() {
+		resource.Spec.ACL = []v1.ChytCliqueACE{
+			{Action: "allow", Subjects: []string{"analysts"}, Permissions: []string{"use"}},
+		}
+		mockYtClient := mock_yt.NewMockClient(ctrl)
+		c := NewChytClique(clique, mockYtClient, strawberryClient)
+		aclPath := ypath.Path("//sys/access_control_object_namespaces/chyt/clique/principal/@principal_acl")
+		acl := []map[string]interface{}{
+			{"action": "allow", "subjects": []string{"analysts"}, "permissions": []string{"use"}},
+		}
+
+		mockYtClient.EXPECT().NodeExists(gomock.Any(), gomock.Eq(aclPath), gomock.Any()).Return(true, nil).Times(2)
+		mockYtClient.EXPECT().GetNode(gomock.Any(), gomock.Eq(aclPath), gomock.Any(), gomock.Any()).
+			SetArg(2, []map[string]interface{}{}).Return(nil)
+		mockYtClient.EXPECT().SetNode(gomock.Any(), gomock.Eq(aclPath), gomock.Eq(acl), gomock.Any()).Return(nil)
+		Expect(c.Sync(context.Background())).Should(Succeed())
+
+		// YT adds the inheritance mode to the stored entries.
+		mockYtClient.EXPECT().GetNode(gomock.Any(), gomock.Eq(aclPath), gomock.Any(), gomock.Any()).
+			SetArg(2, []map[string]interface{}{
+				{
+					"action":           "allow",
+					"subjects":         []interface{}{"analysts"},
+					"permissions":      []interface{}{"use"},
+					"inheritance_mode": "object_and_descendants",
+				},
+			}).Return(nil)
+		Expect(c.Sync(context.Background())).Should(Succeed())
+	})
+
+	It("Clique is not synced without strawberry controller", func() {
+		c := NewChytClique(clique, mock_yt.NewMockClient(ctrl), nil)
+
+		Expect(c.Sync(context.Background())).ShouldNot(Succeed())
+		Expect(resource.Status.Conditions).Should(HaveLen(1))
+		Expect(resource.Status.Conditions[0].Status).Should(Equal(metav1.ConditionFalse))
+
+		// Nothing was created, so nothing is removed.
+		Expect(c.Remove(context.Background())).Should(Succeed())
+	})
+})
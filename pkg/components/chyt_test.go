@@ -0,0 +1,193 @@
+package components
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Chyt test", func() {
+	var ytsaurusSpec *v1.Ytsaurus
+	var resource *v1.Chyt
+	var k8sClient client.Client
+	var scheme *runtime.Scheme
+
+	newChyt := func() *Chyt {
+		cfgen := ytconfig.NewGenerator(ytsaurusSpec, "cluster_domain")
+		chyt := NewChyt(cfgen, apiproxy.NewChyt(resource, k8sClient, record.NewFakeRecorder(10), scheme), ytsaurusSpec)
+		Expect(chyt.Fetch(context.Background())).To(Succeed())
+		return chyt
+	}
+
+	// syncChyt runs the sync steps until the release is blocked or finished.
+	syncChyt := func() ComponentStatus {
+		for i := 0; i < 10; i++ {
+			chyt := newChyt()
+			status := chyt.Status(context.Background())
+			if status.SyncStatus != SyncStatusPending {
+				return status
+			}
+			Expect(chyt.Sync(context.Background())).To(Succeed())
+		}
+		Fail("Chyt is not synced")
+		return ComponentStatus{}
+	}
+
+	getJob := func(job *InitJob) *batchv1.Job {
+		var result batchv1.Job
+		key := client.ObjectKey{Name: job.initJob.Name(), Namespace: "default"}
+		if err := k8sClient.Get(context.Background(), key, &result); err != nil {
+			return nil
+		}
+		return &result
+	}
+
+	getJobImage := func(job *InitJob) string {
+		result := getJob(job)
+		Expect(result).ShouldNot(BeNil())
+		return result.Spec.Template.Spec.Containers[0].Image
+	}
+
+	completeJobs := func() {
+		var jobs batchv1.JobList
+		Expect(k8sClient.List(context.Background(), &jobs)).To(Succeed())
+		for i := range jobs.Items {
+			job := &jobs.Items[i]
+			if job.Status.Succeeded == 0 {
+				job.Status.Succeeded = 1
+				Expect(k8sClient.Status().Update(context.Background(), job)).To(Succeed())
+			}
+		}
+	}
+
+	// releaseChyt completes the release jobs until the release is finished.
+	releaseChyt := func() {
+		for i := 0; i < 10; i++ {
+			status := syncChyt()
+			if status.SyncStatus == SyncStatusReady {
+				return
+			}
+			Expect(status.SyncStatus).Should(Equal(SyncStatusBlocked))
+			completeJobs()
+		}
+		Fail("Chyt is not released")
+	}
+
+	BeforeEach(func() {
+		ytsaurusSpec = &v1.Ytsaurus{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ytsaurus",
+				Namespace: "default",
+			},
+			Spec: v1.YtsaurusSpec{
+				CommonSpec: v1.CommonSpec{
+					CoreImage: "ytsaurus/ytsaurus:latest",
+				},
+				StrawberryController: &v1.StrawberryControllerSpec{},
+			},
+			Status: v1.YtsaurusStatus{
+				State: v1.ClusterStateRunning,
+			},
+		}
+
+		resource = &v1.Chyt{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "chyt",
+				Namespace: "default",
+			},
+			Spec: v1.ChytSpec{
+				Ytsaurus:    &corev1.LocalObjectReference{Name: "ytsaurus"},
+				Image:       "ytsaurus/chyt:1",
+				MakeDefault: true,
+			},
+		}
+
+		scheme = runtime.NewScheme()
+		Expect(v1.AddToScheme(scheme)).To(Succeed())
+		Expect(batchv1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		k8sClient = fake.NewClientBuilder().WithScheme(scheme).WithObjects(ytsaurusSpec, resource).Build()
+
+		// The fake client does not convert string data of secrets, so the token is created in advance.
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      newChyt().secret.Name(),
+				Namespace: "default",
+			},
+			Data: map[string][]byte{consts.TokenSecretKey: []byte("token")},
+		}
+		Expect(k8sClient.Create(context.Background(), secret)).To(Succeed())
+	})
+
+	It("First release is recorded", func() {
+		releaseChyt()
+
+		chyt := newChyt()
+		Expect(getJobImage(chyt.initEnvironment)).Should(Equal("ytsaurus/chyt:1"))
+		Expect(getJobImage(chyt.initChPublicJob)).Should(Equal("ytsaurus/chyt:1"))
+		// There are no cliques of a previous release to restart.
+		Expect(getJob(chyt.restartCliquesJob)).Should(BeNil())
+
+		Expect(resource.Status.ReleaseStatus).Should(Equal(v1.ChytReleaseStatusFinished))
+		Expect(resource.Status.ObservedImage).Should(Equal("ytsaurus/chyt:1"))
+		Expect(resource.Status.ReleasingImage).Should(BeEmpty())
+		Expect(resource.Status.ReleasedImages).Should(Equal([]string{"ytsaurus/chyt:1"}))
+	})
+
+	It("New image is released and the previous release is kept", func() {
+		releaseChyt()
+
+		resource.Spec.Image = "ytsaurus/chyt:2"
+		Expect(syncChyt().SyncStatus).Should(Equal(SyncStatusBlocked))
+		Expect(resource.Status.ReleaseStatus).Should(Equal(v1.ChytReleaseStatusUploadingIntoCypress))
+		Expect(resource.Status.ReleasingImage).Should(Equal("ytsaurus/chyt:2"))
+		Expect(getJobImage(newChyt().initEnvironment)).Should(Equal("ytsaurus/chyt:2"))
+
+		releaseChyt()
+
+		chyt := newChyt()
+		Expect(getJobImage(chyt.initChPublicJob)).Should(Equal("ytsaurus/chyt:2"))
+		// Running cliques are switched to the new default version.
+		Expect(getJobImage(chyt.restartCliquesJob)).Should(Equal("ytsaurus/chyt:2"))
+		Expect(chyt.createRestartCliquesScript()).Should(ContainSubstring("yt clickhouse ctl start $alias --untracked"))
+
+		Expect(resource.Status.ObservedImage).Should(Equal("ytsaurus/chyt:2"))
+		Expect(resource.Status.ReleasedImages).Should(Equal([]string{"ytsaurus/chyt:1", "ytsaurus/chyt:2"}))
+	})
+
+	It("Image changed during a release is released after it", func() {
+		releaseChyt()
+
+		resource.Spec.Image = "ytsaurus/chyt:2"
+		Expect(syncChyt().SyncStatus).Should(Equal(SyncStatusBlocked))
+
+		// The running release keeps its image.
+		resource.Spec.Image = "ytsaurus/chyt:3"
+		Expect(syncChyt().SyncStatus).Should(Equal(SyncStatusBlocked))
+		Expect(resource.Status.ReleasingImage).Should(Equal("ytsaurus/chyt:2"))
+		Expect(getJobImage(newChyt().initEnvironment)).Should(Equal("ytsaurus/chyt:2"))
+
+		releaseChyt()
+		Expect(resource.Status.ObservedImage).Should(Equal("ytsaurus/chyt:2"))
+		Expect(resource.Status.ReleasedImages).Should(Equal([]string{"ytsaurus/chyt:1", "ytsaurus/chyt:2"}))
+
+		releaseChyt()
+		Expect(getJobImage(newChyt().initEnvironment)).Should(Equal("ytsaurus/chyt:3"))
+		Expect(resource.Status.ObservedImage).Should(Equal("ytsaurus/chyt:3"))
+		Expect(resource.Status.ReleasingImage).Should(BeEmpty())
+		Expect(resource.Status.ReleasedImages).Should(Equal(
+			[]string{"ytsaurus/chyt:1", "ytsaurus/chyt:2", "ytsaurus/chyt:3"}))
+	})
+})
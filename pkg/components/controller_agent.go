@@ -32,7 +32,7 @@ func NewControllerAgent(cfgen *ytconfig.Generator, ytsaurus *apiproxy.Ytsaurus,
 		&resource.Spec.ControllerAgents.InstanceSpec,
 		"/usr/bin/ytserver-controller-agent",
 		"ytserver-controller-agent.yson",
-		"ca",
+		cfgen.GetControllerAgentsStatefulSetName(),
 		"controller-agents",
 		cfgen.GetControllerAgentConfig,
 	)
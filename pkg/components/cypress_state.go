@@ -0,0 +1,594 @@
+package components
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/labeller"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yson"
+	"go.ytsaurus.tech/yt/go/yt"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// cypressObjects describes a group of Cypress objects, which are derived from the spec
+// and must be kept in sync with it.
+type cypressObjects struct {
+	name      string
+	condition string
+	// getDrift returns descriptions of the objects which differ from the spec.
+	getDrift func(ctx context.Context, ytClient yt.Client) ([]string, error)
+	sync     func(ctx context.Context, ytClient yt.Client) error
+}
+
+// cypressState converges Cypress objects, which were initially created by init jobs,
+// on every reconciliation and reports the drift as status conditions.
+type cypressState struct {
+	componentBase
+	ytsaurusClient YtsaurusClient
+}
+
+func NewCypressState(cfgen *ytconfig.Generator, ytsaurus *apiproxy.Ytsaurus, yc YtsaurusClient) Component {
+	resource := ytsaurus.GetResource()
+	l := labeller.Labeller{
+		ObjectMeta:     &resource.ObjectMeta,
+		APIProxy:       ytsaurus.APIProxy(),
+		ComponentLabel: consts.YTComponentLabelCypressState,
+		ComponentName:  "CypressState",
+	}
+
+	return &cypressState{
+		componentBase: componentBase{
+			labeller: &l,
+			ytsaurus: ytsaurus,
+			cfgen:    cfgen,
+		},
+		ytsaurusClient: yc,
+	}
+}
+
+func (cs *cypressState) IsUpdatable() bool {
+	return false
+}
+
+func (cs *cypressState) Fetch(ctx context.Context) error {
+	return nil
+}
+
+func (cs *cypressState) getObjects() []cypressObjects {
+	return []cypressObjects{
+		{
+			name:      "cluster connection",
+			condition: consts.ConditionClusterConnectionInSync,
+			getDrift:  cs.getClusterConnectionDrift,
+			sync:      cs.syncClusterConnection,
+		},
+		{
+			name:      "media",
+			condition: consts.ConditionMediaInSync,
+			getDrift:  cs.getMediaDrift,
+			sync:      cs.syncMedia,
+		},
+		{
+			name:      "pool trees",
+			condition: consts.ConditionPoolTreesInSync,
+			getDrift:  cs.getPoolTreesDrift,
+			sync:      cs.syncPoolTrees,
+		},
+		{
+			name:      "tablet cell bundles",
+			condition: consts.ConditionTabletCellBundlesInSync,
+			getDrift:  cs.getTabletCellBundlesDrift,
+			sync:      cs.syncTabletCellBundles,
+		},
+		{
+			name:      "remote clusters",
+			condition: consts.ConditionRemoteClustersInSync,
+			getDrift:  cs.getRemoteClustersDrift,
+			sync:      cs.syncRemoteClusters,
+		},
+	}
+}
+
+func (cs *cypressState) getClusterConnection() (map[string]interface{}, error) {
+	data, err := cs.cfgen.GetClusterConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	var clusterConnection map[string]interface{}
+	err = yson.Unmarshal(data, &clusterConnection)
+	return clusterConnection, err
+}
+
+// getClusterConnectionDrift compares only the keys generated by the operator,
+// other components (e.g. query tracker and yql agent) add their own keys.
+func (cs *cypressState) getClusterConnectionDrift(ctx context.Context, ytClient yt.Client) ([]string, error) {
+	expected, err := cs.getClusterConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	path := ypath.Path("//sys/@cluster_connection")
+	exists, err := ytClient.NodeExists(ctx, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return []string{"cluster_connection"}, nil
+	}
+
+	var actual map[string]interface{}
+	if err = ytClient.GetNode(ctx, path, &actual, nil); err != nil {
+		return nil, err
+	}
+
+	drift := make([]string, 0)
+	for key, value := range expected {
+		equal, err := isEqualNodeValue(actual[key], value)
+		if err != nil {
+			return nil, err
+		}
+		if !equal {
+			drift = append(drift, key)
+		}
+	}
+	sort.Strings(drift)
+
+	return drift, nil
+}
+
+func (cs *cypressState) syncClusterConnection(ctx context.Context, ytClient yt.Client) error {
+	expected, err := cs.getClusterConnection()
+	if err != nil {
+		return err
+	}
+
+	drift, err := cs.getClusterConnectionDrift(ctx, ytClient)
+	if err != nil {
+		return err
+	}
+
+	path := ypath.Path("//sys/@cluster_connection")
+	for _, key := range drift {
+		if key == "cluster_connection" {
+			return ytClient.SetNode(ctx, path, expected, nil)
+		}
+		if err = ytClient.SetNode(ctx, path.Child(key), expected[key], nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cs *cypressState) getMediaDrift(ctx context.Context, ytClient yt.Client) ([]string, error) {
+	drift := make([]string, 0)
+	for _, medium := range getExtraMedia(cs.ytsaurus.GetResource()) {
+		exists, err := ytClient.NodeExists(ctx, ypath.Path(fmt.Sprintf("//sys/media/%s", medium.Name)), nil)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			drift = append(drift, medium.Name)
+		}
+	}
+
+	return drift, nil
+}
+
+func (cs *cypressState) syncMedia(ctx context.Context, ytClient yt.Client) error {
+	missingMedia, err := cs.getMediaDrift(ctx, ytClient)
+	if err != nil {
+		return err
+	}
+
+	for _, medium := range missingMedia {
+		_, err = ytClient.CreateObject(ctx, yt.NodeMedium, &yt.CreateObjectOptions{
+			IgnoreExisting: true,
+			Attributes: map[string]interface{}{
+				"name": medium,
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cs *cypressState) getDefaultPoolTree(ctx context.Context) (string, error) {
+	resource := cs.ytsaurus.GetResource()
+	return getDefaultPoolTree(ctx, cs.ytsaurus.APIProxy().Client(), resource.Namespace, resource.Name)
+}
+
+func (cs *cypressState) getPoolTreesDrift(ctx context.Context, ytClient yt.Client) ([]string, error) {
+	drift := make([]string, 0)
+
+	poolTreePath := ypath.Path(fmt.Sprintf("//sys/pool_trees/%s", consts.DefaultPoolTree))
+	for _, path := range []ypath.Path{poolTreePath, poolTreePath.Child(consts.DefaultPool)} {
+		exists, err := ytClient.NodeExists(ctx, path, nil)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			drift = append(drift, path.String())
+		}
+	}
+
+	expectedDefaultTree, err := cs.getDefaultPoolTree(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultTreePath := ypath.Path("//sys/pool_trees/@default_tree")
+	exists, err := ytClient.NodeExists(ctx, defaultTreePath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultTree := ""
+	if exists {
+		if err = ytClient.GetNode(ctx, defaultTreePath, &defaultTree, nil); err != nil {
+			return nil, err
+		}
+	}
+	if defaultTree != expectedDefaultTree {
+		drift = append(drift, defaultTreePath.String())
+	}
+
+	return drift, nil
+}
+
+func (cs *cypressState) syncPoolTrees(ctx context.Context, ytClient yt.Client) error {
+	_, err := ytClient.CreateObject(ctx, yt.NodeSchedulerPoolTree, &yt.CreateObjectOptions{
+		IgnoreExisting: true,
+		Attributes: map[string]interface{}{
+			"name": consts.DefaultPoolTree,
+			"config": map[string]interface{}{
+				"nodes_filter": "",
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	defaultTree, err := cs.getDefaultPoolTree(ctx)
+	if err != nil {
+		return err
+	}
+	if err = syncNodeAttribute(ctx, ytClient, ypath.Path("//sys/pool_trees/@default_tree"), defaultTree); err != nil {
+		return err
+	}
+
+	_, err = ytClient.CreateObject(ctx, yt.NodeSchedulerPool, &yt.CreateObjectOptions{
+		IgnoreExisting: true,
+		Attributes: map[string]interface{}{
+			"name":      consts.DefaultPool,
+			"pool_tree": consts.DefaultPoolTree,
+		},
+	})
+	return err
+}
+
+type TabletCellBundleOptions struct {
+	ChangelogPrimaryMedium string `yson:"changelog_primary_medium"`
+	SnapshotPrimaryMedium  string `yson:"snapshot_primary_medium"`
+}
+
+func (cs *cypressState) getExpectedBundleOptions(bundle string) map[string]string {
+	options := make(map[string]string)
+
+	bootstrap := getBundleBootstrap(cs.ytsaurus.GetResource(), bundle)
+	if bootstrap == nil {
+		return options
+	}
+
+	if bootstrap.ChangelogPrimaryMedium != nil {
+		options["changelog_primary_medium"] = *bootstrap.ChangelogPrimaryMedium
+	}
+	if bootstrap.SnapshotPrimaryMedium != nil {
+		options["snapshot_primary_medium"] = *bootstrap.SnapshotPrimaryMedium
+	}
+
+	return options
+}
+
+// getTabletCellBundlesDrift returns the bundle options which differ from the bootstrap spec.
+// Bundles are created by tablet nodes, so the missing ones are skipped.
+// Bundles managed by TabletCellBundle resources are skipped as well, their options are set by the resources.
+func (cs *cypressState) getTabletCellBundlesDrift(ctx context.Context, ytClient yt.Client) ([]string, error) {
+	drift := make([]string, 0)
+	resource := cs.ytsaurus.GetResource()
+	if len(resource.Spec.TabletNodes) == 0 {
+		return drift, nil
+	}
+
+	managedBundles, err := getManagedBundles(ctx, cs.ytsaurus.APIProxy().Client(), resource.Namespace, resource.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, bundle := range []string{SysBundle, DefaultBundle} {
+		expected := cs.getExpectedBundleOptions(bundle)
+		if len(expected) == 0 || managedBundles[bundle] {
+			continue
+		}
+
+		path := ypath.Path(fmt.Sprintf("//sys/tablet_cell_bundles/%s", bundle))
+		exists, err := ytClient.NodeExists(ctx, path, nil)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			continue
+		}
+
+		var actual TabletCellBundleOptions
+		if err = ytClient.GetNode(ctx, path.Attr("options"), &actual, nil); err != nil {
+			return nil, err
+		}
+
+		actualOptions := map[string]string{
+			"changelog_primary_medium": actual.ChangelogPrimaryMedium,
+			"snapshot_primary_medium":  actual.SnapshotPrimaryMedium,
+		}
+		for key, value := range expected {
+			if actualOptions[key] != value {
+				drift = append(drift, fmt.Sprintf("%s/%s", bundle, key))
+			}
+		}
+	}
+	sort.Strings(drift)
+
+	return drift, nil
+}
+
+func (cs *cypressState) syncTabletCellBundles(ctx context.Context, ytClient yt.Client) error {
+	drift, err := cs.getTabletCellBundlesDrift(ctx, ytClient)
+	if err != nil {
+		return err
+	}
+
+	for _, option := range drift {
+		bundle, key, _ := strings.Cut(option, "/")
+		path := ypath.Path(fmt.Sprintf("//sys/tablet_cell_bundles/%s", bundle)).Attr("options").Child(key)
+		if err = ytClient.SetNode(ctx, path, cs.getExpectedBundleOptions(bundle)[key], nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cs *cypressState) getRemoteClusterConnection(ctx context.Context, spec ytv1.RemoteClusterSpec) (map[string]interface{}, error) {
+	var data []byte
+	if spec.ClusterConnection != nil {
+		data = []byte(*spec.ClusterConnection)
+	} else {
+		namespace := spec.Ytsaurus.Namespace
+		if namespace == "" {
+			namespace = cs.ytsaurus.GetResource().Namespace
+		}
+
+		var remote ytv1.Ytsaurus
+		key := types.NamespacedName{Name: spec.Ytsaurus.Name, Namespace: namespace}
+		if err := cs.ytsaurus.APIProxy().Client().Get(ctx, key, &remote); err != nil {
+			return nil, fmt.Errorf("failed to get ytsaurus %s for remote cluster %s: %w", key, spec.Name, err)
+		}
+
+		var err error
+		if data, err = cs.cfgen.GetRemoteClusterConnection(&remote); err != nil {
+			return nil, err
+		}
+	}
+
+	var clusterConnection map[string]interface{}
+	err := yson.Unmarshal(data, &clusterConnection)
+	return clusterConnection, err
+}
+
+func (cs *cypressState) getActualClusters(ctx context.Context, ytClient yt.Client) (map[string]interface{}, error) {
+	actual := make(map[string]interface{})
+	path := ypath.Path("//sys/clusters")
+	exists, err := ytClient.NodeExists(ctx, path, nil)
+	if err != nil || !exists {
+		return actual, err
+	}
+	err = ytClient.GetNode(ctx, path, &actual, nil)
+	return actual, err
+}
+
+// getExpectedClusters returns the content of //sys/clusters managed by the operator:
+// the local cluster connection and the connections of all remote clusters from the spec.
+// The connections are merged into the actual entries, so the options set by others are kept.
+func (cs *cypressState) getExpectedClusters(ctx context.Context, ytClient yt.Client, actual map[string]interface{}) (map[string]interface{}, error) {
+	var localClusterConnection map[string]interface{}
+	if err := ytClient.GetNode(ctx, ypath.Path("//sys/@cluster_connection"), &localClusterConnection, nil); err != nil {
+		return nil, err
+	}
+
+	clusters := map[string]interface{}{
+		cs.labeller.GetClusterName(): mergeNodeValue(actual[cs.labeller.GetClusterName()], localClusterConnection),
+	}
+	for _, spec := range cs.ytsaurus.GetResource().Spec.RemoteClusters {
+		clusterConnection, err := cs.getRemoteClusterConnection(ctx, spec)
+		if err != nil {
+			return nil, err
+		}
+		clusters[spec.Name] = mergeNodeValue(actual[spec.Name], clusterConnection)
+	}
+
+	return clusters, nil
+}
+
+// getClustersDrift returns the names of clusters which differ from the expected ones,
+// including the ones registered by the operator earlier and removed from the spec since then.
+func (cs *cypressState) getClustersDrift(expected, actual map[string]interface{}) ([]string, error) {
+	drift := make([]string, 0)
+	for name, value := range expected {
+		equal, err := isEqualNodeValue(actual[name], value)
+		if err != nil {
+			return nil, err
+		}
+		if !equal {
+			drift = append(drift, name)
+		}
+	}
+	for _, name := range cs.ytsaurus.GetResource().Status.RemoteClusters {
+		if _, ok := expected[name]; ok {
+			continue
+		}
+		if _, ok := actual[name]; ok {
+			drift = append(drift, name)
+		}
+	}
+	sort.Strings(drift)
+
+	return drift, nil
+}
+
+func (cs *cypressState) getRemoteClustersDrift(ctx context.Context, ytClient yt.Client) ([]string, error) {
+	actual, err := cs.getActualClusters(ctx, ytClient)
+	if err != nil {
+		return nil, err
+	}
+	expected, err := cs.getExpectedClusters(ctx, ytClient, actual)
+	if err != nil {
+		return nil, err
+	}
+	return cs.getClustersDrift(expected, actual)
+}
+
+func (cs *cypressState) syncRemoteClusters(ctx context.Context, ytClient yt.Client) error {
+	actual, err := cs.getActualClusters(ctx, ytClient)
+	if err != nil {
+		return err
+	}
+	expected, err := cs.getExpectedClusters(ctx, ytClient, actual)
+	if err != nil {
+		return err
+	}
+	drift, err := cs.getClustersDrift(expected, actual)
+	if err != nil {
+		return err
+	}
+
+	path := ypath.Path("//sys/clusters")
+	_, err = ytClient.CreateNode(ctx, path, yt.NodeDocument, &yt.CreateNodeOptions{
+		IgnoreExisting: true,
+		Attributes: map[string]interface{}{
+			"value": map[string]interface{}{},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, name := range drift {
+		if value, ok := expected[name]; ok {
+			err = ytClient.SetNode(ctx, path.Child(name), value, nil)
+		} else {
+			err = ytClient.RemoveNode(ctx, path.Child(name), nil)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	remoteClusters := make([]string, 0, len(cs.ytsaurus.GetResource().Spec.RemoteClusters))
+	for _, spec := range cs.ytsaurus.GetResource().Spec.RemoteClusters {
+		remoteClusters = append(remoteClusters, spec.Name)
+	}
+	sort.Strings(remoteClusters)
+	cs.ytsaurus.GetResource().Status.RemoteClusters = remoteClusters
+
+	return nil
+}
+
+func (cs *cypressState) setDriftCondition(objects cypressObjects, drift []string) {
+	if len(drift) == 0 {
+		cs.ytsaurus.SetStatusCondition(metav1.Condition{
+			Type:    objects.condition,
+			Status:  metav1.ConditionTrue,
+			Reason:  "InSync",
+			Message: fmt.Sprintf("Cypress %s are in sync with the spec", objects.name),
+		})
+		return
+	}
+
+	cs.ytsaurus.SetStatusCondition(metav1.Condition{
+		Type:    objects.condition,
+		Status:  metav1.ConditionFalse,
+		Reason:  "Drift",
+		Message: fmt.Sprintf("Cypress %s differ from the spec: %s", objects.name, strings.Join(drift, ", ")),
+	})
+}
+
+func (cs *cypressState) doSync(ctx context.Context, dry bool) (ComponentStatus, error) {
+	var err error
+	logger := log.FromContext(ctx)
+
+	if cs.ytsaurus.GetClusterState() == ytv1.ClusterStateUpdating {
+		return NewComponentStatus(SyncStatusReady, "Not updating component"), err
+	}
+
+	if cs.ytsaurusClient.Status(ctx).SyncStatus != SyncStatusReady {
+		return WaitingStatus(SyncStatusBlocked, cs.ytsaurusClient.GetName()), err
+	}
+
+	ytClient := cs.ytsaurusClient.GetYtClient()
+
+	var drifted []string
+	for _, objects := range cs.getObjects() {
+		drift, err := objects.getDrift(ctx, ytClient)
+		if err != nil {
+			// Cypress may be temporarily unavailable, it is not a reason to fail the reconciliation.
+			logger.Error(err, "Getting cypress state failed", "objects", objects.name)
+			return NewComponentStatus(SyncStatusBlocked, fmt.Sprintf("Failed to get %s", objects.name)), nil
+		}
+
+		cs.setDriftCondition(objects, drift)
+		if len(drift) == 0 {
+			continue
+		}
+
+		drifted = append(drifted, objects.name)
+		if !dry {
+			logger.Info("Syncing cypress state", "objects", objects.name, "drift", drift)
+			if err = objects.sync(ctx, ytClient); err != nil {
+				return WaitingStatus(SyncStatusPending, objects.name), err
+			}
+		}
+	}
+
+	if len(drifted) > 0 {
+		return WaitingStatus(SyncStatusPending, strings.Join(drifted, ", ")), err
+	}
+
+	return SimpleStatus(SyncStatusReady), err
+}
+
+func (cs *cypressState) Status(ctx context.Context) ComponentStatus {
+	status, err := cs.doSync(ctx, true)
+	if err != nil {
+		panic(err)
+	}
+
+	return status
+}
+
+func (cs *cypressState) Sync(ctx context.Context) error {
+	_, err := cs.doSync(ctx, false)
+	return err
+}
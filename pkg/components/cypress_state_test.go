@@ -0,0 +1,347 @@
+package components
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	mock_yt "github.com/ytsaurus/yt-k8s-operator/pkg/mock"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
+	"go.ytsaurus.tech/yt/go/guid"
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ptr "k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Cypress state test", func() {
+	var ytsaurusSpec *v1.Ytsaurus
+	var mockYtClient *mock_yt.MockClient
+	var ytsaurus *apiproxy.Ytsaurus
+	var cs *cypressState
+
+	BeforeEach(func() {
+		mockYtClient = mock_yt.NewMockClient(ctrl)
+
+		ytsaurusSpec = &v1.Ytsaurus{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ytsaurus",
+				Namespace: "default",
+			},
+			Spec: v1.YtsaurusSpec{
+				CommonSpec: v1.CommonSpec{
+					CoreImage: "ytsaurus/ytsaurus:latest",
+				},
+				DataNodes: []v1.DataNodesSpec{
+					{
+						InstanceSpec: v1.InstanceSpec{
+							InstanceCount: 1,
+							Locations: []v1.LocationSpec{
+								{
+									LocationType: "ChunkStore",
+									Path:         "/yt/node-data/chunk-store",
+									Medium:       "default",
+								},
+								{
+									LocationType: "ChunkStore",
+									Path:         "/yt/node-data/chunk-store-ssd",
+									Medium:       "ssd",
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		scheme := runtime.NewScheme()
+		Expect(v1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ytsaurusSpec).Build()
+
+		cfgen := ytconfig.NewGenerator(ytsaurusSpec, "cluster_domain")
+		ytsaurus = apiproxy.NewYtsaurus(ytsaurusSpec, k8sClient, record.NewFakeRecorder(10), scheme)
+		cs = NewCypressState(cfgen, ytsaurus, NewFakeYtsaurusClient(mockYtClient)).(*cypressState)
+	})
+
+	mockClusterConnection := func(clusterConnection map[string]interface{}) {
+		mockYtClient.EXPECT().
+			NodeExists(gomock.Any(), gomock.Eq(ypath.Path("//sys/@cluster_connection")), gomock.Nil()).
+			Return(true, nil)
+		mockYtClient.EXPECT().
+			GetNode(gomock.Any(), gomock.Eq(ypath.Path("//sys/@cluster_connection")), gomock.Any(), gomock.Nil()).
+			DoAndReturn(func(_ context.Context, _ ypath.YPath, result interface{}, _ *yt.GetNodeOptions) error {
+				*result.(*map[string]interface{}) = clusterConnection
+				return nil
+			})
+	}
+
+	It("Node values are compared regardless of their types", func() {
+		type options struct {
+			Count int    `yson:"count"`
+			Name  string `yson:"name"`
+		}
+
+		for _, actual := range []interface{}{
+			map[string]interface{}{"name": "test", "count": int64(1)},
+			map[string]interface{}{"count": uint64(1), "name": "test"},
+			map[string]interface{}{"count": float64(1), "name": "test"},
+		} {
+			equal, err := isEqualNodeValue(actual, options{Count: 1, Name: "test"})
+			Expect(err).Should(Succeed())
+			Expect(equal).Should(BeTrue())
+		}
+
+		equal, err := isEqualNodeValue(map[string]interface{}{"count": uint64(2), "name": "test"}, options{Count: 1, Name: "test"})
+		Expect(err).Should(Succeed())
+		Expect(equal).Should(BeFalse())
+
+		equal, err = isEqualNodeValue(nil, options{})
+		Expect(err).Should(Succeed())
+		Expect(equal).Should(BeFalse())
+	})
+
+	It("Cluster connection with keys of other components is in sync", func() {
+		expected, err := cs.getClusterConnection()
+		Expect(err).Should(Succeed())
+
+		actual := map[string]interface{}{
+			"yql_agent": map[string]interface{}{"stages": map[string]interface{}{}},
+		}
+		for key, value := range expected {
+			actual[key] = value
+		}
+		mockClusterConnection(actual)
+
+		objects := cs.getObjects()[0]
+		drift, err := objects.getDrift(context.Background(), mockYtClient)
+		Expect(err).Should(Succeed())
+		Expect(drift).Should(BeEmpty())
+
+		cs.setDriftCondition(objects, drift)
+		Expect(ytsaurus.IsStatusConditionTrue(consts.ConditionClusterConnectionInSync)).Should(BeTrue())
+	})
+
+	It("Changed cluster connection keys are reported and restored", func() {
+		expected, err := cs.getClusterConnection()
+		Expect(err).Should(Succeed())
+
+		actual := map[string]interface{}{}
+		for key, value := range expected {
+			actual[key] = value
+		}
+		actual["cluster_name"] = "other"
+		delete(actual, "discovery_connection")
+
+		mockClusterConnection(actual)
+		mockClusterConnection(actual)
+		mockYtClient.EXPECT().
+			SetNode(gomock.Any(), gomock.Eq(ypath.Path("//sys/@cluster_connection/cluster_name")), gomock.Eq("ytsaurus"), gomock.Nil()).
+			Return(nil)
+		mockYtClient.EXPECT().
+			SetNode(gomock.Any(), gomock.Eq(ypath.Path("//sys/@cluster_connection/discovery_connection")), gomock.Eq(expected["discovery_connection"]), gomock.Nil()).
+			Return(nil)
+
+		objects := cs.getObjects()[0]
+		drift, err := objects.getDrift(context.Background(), mockYtClient)
+		Expect(err).Should(Succeed())
+		Expect(drift).Should(Equal([]string{"cluster_name", "discovery_connection"}))
+
+		cs.setDriftCondition(objects, drift)
+		Expect(ytsaurus.IsStatusConditionFalse(consts.ConditionClusterConnectionInSync)).Should(BeTrue())
+
+		Expect(objects.sync(context.Background(), mockYtClient)).Should(Succeed())
+	})
+
+	mockPoolTrees := func(defaultTree string) {
+		for _, path := range []string{"//sys/pool_trees/default", "//sys/pool_trees/default/research", "//sys/pool_trees/@default_tree"} {
+			mockYtClient.EXPECT().
+				NodeExists(gomock.Any(), gomock.Eq(ypath.Path(path)), gomock.Nil()).
+				Return(true, nil)
+		}
+		mockYtClient.EXPECT().
+			GetNode(gomock.Any(), gomock.Eq(ypath.Path("//sys/pool_trees/@default_tree")), gomock.Any(), gomock.Nil()).
+			DoAndReturn(func(_ context.Context, _ ypath.YPath, result interface{}, _ *yt.GetNodeOptions) error {
+				*result.(*string) = defaultTree
+				return nil
+			})
+	}
+
+	It("Changed default pool tree is reported", func() {
+		mockPoolTrees("other")
+
+		drift, err := cs.getObjects()[2].getDrift(context.Background(), mockYtClient)
+		Expect(err).Should(Succeed())
+		Expect(drift).Should(Equal([]string{"//sys/pool_trees/@default_tree"}))
+	})
+
+	It("Default pool tree is owned by scheduler pool tree resources", func() {
+		poolTree := &v1.SchedulerPoolTree{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "gpu",
+				Namespace: "default",
+			},
+			Spec: v1.SchedulerPoolTreeSpec{
+				Ytsaurus: corev1.LocalObjectReference{Name: "ytsaurus"},
+				Default:  true,
+			},
+		}
+		Expect(ytsaurus.APIProxy().Client().Create(context.Background(), poolTree)).Should(Succeed())
+
+		mockPoolTrees("gpu")
+
+		drift, err := cs.getObjects()[2].getDrift(context.Background(), mockYtClient)
+		Expect(err).Should(Succeed())
+		Expect(drift).Should(BeEmpty())
+	})
+
+	It("Bundles managed by tablet cell bundle resources are skipped", func() {
+		ytsaurusSpec.Spec.TabletNodes = []v1.TabletNodesSpec{
+			{InstanceSpec: v1.InstanceSpec{InstanceCount: 1}},
+		}
+		ytsaurusSpec.Spec.Bootstrap = &v1.BootstrapSpec{
+			TabletCellBundles: &v1.BundlesBootstrapSpec{
+				Sys:     &v1.BundleBootstrapSpec{SnapshotPrimaryMedium: ptr.String("ssd")},
+				Default: &v1.BundleBootstrapSpec{SnapshotPrimaryMedium: ptr.String("ssd")},
+			},
+		}
+
+		bundle := &v1.TabletCellBundle{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "default-bundle",
+				Namespace: "default",
+			},
+			Spec: v1.TabletCellBundleSpec{
+				Ytsaurus:   corev1.LocalObjectReference{Name: "ytsaurus"},
+				BundleName: "default",
+			},
+		}
+		Expect(ytsaurus.APIProxy().Client().Create(context.Background(), bundle)).Should(Succeed())
+
+		sysPath := ypath.Path("//sys/tablet_cell_bundles/sys")
+		mockYtClient.EXPECT().
+			NodeExists(gomock.Any(), gomock.Eq(sysPath), gomock.Nil()).
+			Return(true, nil)
+		mockYtClient.EXPECT().
+			GetNode(gomock.Any(), gomock.Eq(sysPath.Attr("options")), gomock.Any(), gomock.Nil()).
+			DoAndReturn(func(_ context.Context, _ ypath.YPath, result interface{}, _ *yt.GetNodeOptions) error {
+				*result.(*TabletCellBundleOptions) = TabletCellBundleOptions{SnapshotPrimaryMedium: "default"}
+				return nil
+			})
+
+		drift, err := cs.getObjects()[3].getDrift(context.Background(), mockYtClient)
+		Expect(err).Should(Succeed())
+		Expect(drift).Should(Equal([]string{"sys/snapshot_primary_medium"}))
+	})
+
+	It("Missing medium is reported and created", func() {
+		mediumPath := ypath.Path("//sys/media/ssd")
+		gomock.InOrder(
+			mockYtClient.EXPECT().
+				NodeExists(gomock.Any(), gomock.Eq(mediumPath), gomock.Nil()).
+				Return(false, nil),
+			mockYtClient.EXPECT().
+				NodeExists(gomock.Any(), gomock.Eq(mediumPath), gomock.Nil()).
+				Return(false, nil),
+			mockYtClient.EXPECT().
+				CreateObject(gomock.Any(), gomock.Eq(yt.NodeMedium), gomock.Any()).
+				Return(yt.NodeID(guid.New()), nil),
+		)
+
+		objects := cs.getObjects()[1]
+		drift, err := objects.getDrift(context.Background(), mockYtClient)
+		Expect(err).Should(Succeed())
+		Expect(drift).Should(Equal([]string{"ssd"}))
+
+		cs.setDriftCondition(objects, drift)
+		Expect(ytsaurus.IsStatusConditionFalse(consts.ConditionMediaInSync)).Should(BeTrue())
+
+		Expect(objects.sync(context.Background(), mockYtClient)).Should(Succeed())
+	})
+
+	It("Existing medium is not reported", func() {
+		mockYtClient.EXPECT().
+			NodeExists(gomock.Any(), gomock.Eq(ypath.Path("//sys/media/ssd")), gomock.Nil()).
+			Return(true, nil)
+
+		objects := cs.getObjects()[1]
+		drift, err := objects.getDrift(context.Background(), mockYtClient)
+		Expect(err).Should(Succeed())
+		Expect(drift).Should(BeEmpty())
+
+		cs.setDriftCondition(objects, drift)
+		Expect(ytsaurus.IsStatusConditionTrue(consts.ConditionMediaInSync)).Should(BeTrue())
+	})
+
+	It("Remote clusters are registered and stale ones are removed", func() {
+		ytsaurus.GetResource().Spec.RemoteClusters = []v1.RemoteClusterSpec{
+			{
+				Name:              "remote",
+				ClusterConnection: ptr.String("{cluster_name=remote}"),
+			},
+		}
+		ytsaurus.GetResource().Status.RemoteClusters = []string{"stale"}
+
+		localClusterConnection := map[string]interface{}{"cluster_name": "ytsaurus"}
+		mockYtClient.EXPECT().
+			GetNode(gomock.Any(), gomock.Eq(ypath.Path("//sys/@cluster_connection")), gomock.Any(), gomock.Nil()).
+			DoAndReturn(func(_ context.Context, _ ypath.YPath, result interface{}, _ *yt.GetNodeOptions) error {
+				*result.(*map[string]interface{}) = localClusterConnection
+				return nil
+			})
+		mockYtClient.EXPECT().
+			NodeExists(gomock.Any(), gomock.Eq(ypath.Path("//sys/clusters")), gomock.Nil()).
+			Return(true, nil)
+		mockYtClient.EXPECT().
+			GetNode(gomock.Any(), gomock.Eq(ypath.Path("//sys/clusters")), gomock.Any(), gomock.Nil()).
+			DoAndReturn(func(_ context.Context, _ ypath.YPath, result interface{}, _ *yt.GetNodeOptions) error {
+				*result.(*map[string]interface{}) = map[string]interface{}{
+					"ytsaurus": localClusterConnection,
+					"stale":    map[string]interface{}{"cluster_name": "stale"},
+				}
+				return nil
+			})
+
+		objects := cs.getObjects()[4]
+		drift, err := objects.getDrift(context.Background(), mockYtClient)
+		Expect(err).Should(Succeed())
+		Expect(drift).Should(Equal([]string{"remote", "stale"}))
+	})
+
+	It("Options set by others in cluster entries are kept", func() {
+		localClusterConnection := map[string]interface{}{"cluster_name": "ytsaurus"}
+		mockYtClient.EXPECT().
+			GetNode(gomock.Any(), gomock.Eq(ypath.Path("//sys/@cluster_connection")), gomock.Any(), gomock.Nil()).
+			DoAndReturn(func(_ context.Context, _ ypath.YPath, result interface{}, _ *yt.GetNodeOptions) error {
+				*result.(*map[string]interface{}) = localClusterConnection
+				return nil
+			})
+		mockYtClient.EXPECT().
+			NodeExists(gomock.Any(), gomock.Eq(ypath.Path("//sys/clusters")), gomock.Nil()).
+			Return(true, nil)
+		mockYtClient.EXPECT().
+			GetNode(gomock.Any(), gomock.Eq(ypath.Path("//sys/clusters")), gomock.Any(), gomock.Nil()).
+			DoAndReturn(func(_ context.Context, _ ypath.YPath, result interface{}, _ *yt.GetNodeOptions) error {
+				*result.(*map[string]interface{}) = map[string]interface{}{
+					"ytsaurus": map[string]interface{}{
+						"cluster_name":          "ytsaurus",
+						"enable_native_tracing": true,
+					},
+				}
+				return nil
+			})
+
+		objects := cs.getObjects()[4]
+		drift, err := objects.getDrift(context.Background(), mockYtClient)
+		Expect(err).Should(Succeed())
+		Expect(drift).Should(BeEmpty())
+	})
+})
@@ -8,6 +8,7 @@ import (
 	"github.com/ytsaurus/yt-k8s-operator/pkg/labeller"
 	"github.com/ytsaurus/yt-k8s-operator/pkg/resources"
 	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	ptr "k8s.io/utils/pointer"
@@ -92,18 +93,8 @@ func (n *execNode) doSync(ctx context.Context, dry bool) (ComponentStatus, error
 
 	if n.server.needSync() {
 		if !dry {
-			statefulSet := n.server.buildStatefulSet()
-			containers := &statefulSet.Spec.Template.Spec.Containers
-			if len(*containers) != 1 {
-				log.Panicf("length of exec node containers is expected to be 1, actual %v", len(*containers))
-			}
-			(*containers)[0].SecurityContext = &corev1.SecurityContext{Privileged: ptr.Bool(n.privileged)}
-			for _, sidecarSpec := range n.sidecars {
-				sidecar := corev1.Container{}
-				if err := yaml.Unmarshal([]byte(sidecarSpec), &sidecar); err != nil {
-					return WaitingStatus(SyncStatusBlocked, "invalid sidecar"), err
-				}
-				*containers = append(*containers, sidecar)
+			if err := setExecNodeContainers(n.server.buildStatefulSet(), n.privileged, n.sidecars); err != nil {
+				return WaitingStatus(SyncStatusBlocked, "invalid sidecar"), err
 			}
 			err = n.server.Sync(ctx)
 		}
@@ -130,3 +121,20 @@ func (n *execNode) Sync(ctx context.Context) error {
 	_, err := n.doSync(ctx, false)
 	return err
 }
+
+// setExecNodeContainers configures the privileges of the exec node container and adds the sidecars.
+func setExecNodeContainers(statefulSet *appsv1.StatefulSet, privileged bool, sidecars []string) error {
+	containers := &statefulSet.Spec.Template.Spec.Containers
+	if len(*containers) != 1 {
+		log.Panicf("length of exec node containers is expected to be 1, actual %v", len(*containers))
+	}
+	(*containers)[0].SecurityContext = &corev1.SecurityContext{Privileged: ptr.Bool(privileged)}
+	for _, sidecarSpec := range sidecars {
+		sidecar := corev1.Container{}
+		if err := yaml.Unmarshal([]byte(sidecarSpec), &sidecar); err != nil {
+			return err
+		}
+		*containers = append(*containers, sidecar)
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+package components
+
+import (
+	"context"
+
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/labeller"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/resources"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+// externalAccess exposes a service outside of the Kubernetes cluster
+// by an Ingress or a Gateway API HTTPRoute, depending on the spec.
+// The objects which are not requested by the spec anymore are removed.
+type externalAccess struct {
+	// spec is nil if the external access is not configured.
+	spec        *ytv1.ExternalAccessSpec
+	serviceName string
+	servicePort int32
+	labeller    *labeller.Labeller
+
+	ingress   *resources.Ingress
+	httpRoute *resources.HTTPRoute
+}
+
+func newExternalAccess(
+	spec *ytv1.ExternalAccessSpec,
+	serviceName string,
+	servicePort int32,
+	labeller *labeller.Labeller,
+	apiProxy apiproxy.APIProxy) *externalAccess {
+	return &externalAccess{
+		spec:        spec,
+		serviceName: serviceName,
+		servicePort: servicePort,
+		labeller:    labeller,
+		ingress:     resources.NewIngress(serviceName, labeller, apiProxy),
+		httpRoute:   resources.NewHTTPRoute(serviceName, labeller, apiProxy),
+	}
+}
+
+func (ea *externalAccess) Name() string {
+	return ea.serviceName
+}
+
+func (ea *externalAccess) needIngress() bool {
+	return ea.spec != nil && ea.spec.Ingress != nil
+}
+
+func (ea *externalAccess) needHTTPRoute() bool {
+	return ea.spec != nil && ea.spec.HTTPRoute != nil
+}
+
+func (ea *externalAccess) Fetch(ctx context.Context) error {
+	if err := ea.ingress.Fetch(ctx); err != nil {
+		return err
+	}
+	err := ea.httpRoute.Fetch(ctx)
+	// HTTPRoute CRD may be missing in the cluster, then there is nothing to remove.
+	if err != nil && !ea.needHTTPRoute() && meta.IsNoMatchError(err) {
+		return nil
+	}
+	return err
+}
+
+func (ea *externalAccess) buildIngress() *networkingv1.Ingress {
+	spec := ea.spec.Ingress
+	ingress := ea.ingress.Build()
+	ingress.Annotations = spec.Annotations
+
+	pathType := networkingv1.PathTypePrefix
+	ingress.Spec = networkingv1.IngressSpec{
+		IngressClassName: spec.IngressClassName,
+		Rules: []networkingv1.IngressRule{
+			{
+				Host: ea.spec.Hostname,
+				IngressRuleValue: networkingv1.IngressRuleValue{
+					HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{
+							{
+								Path:     "/",
+								PathType: &pathType,
+								Backend: networkingv1.IngressBackend{
+									Service: &networkingv1.IngressServiceBackend{
+										Name: ea.serviceName,
+										Port: networkingv1.ServiceBackendPort{
+											Number: ea.servicePort,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if spec.TLSSecret != nil {
+		ingress.Spec.TLS = []networkingv1.IngressTLS{
+			{
+				Hosts:      []string{ea.spec.Hostname},
+				SecretName: spec.TLSSecret.Name,
+			},
+		}
+	}
+
+	return ingress
+}
+
+func (ea *externalAccess) buildHTTPRoute() {
+	var parentRefs []interface{}
+	for _, ref := range ea.spec.HTTPRoute.ParentRefs {
+		parentRef := map[string]interface{}{
+			"name": ref.Name,
+		}
+		if ref.Namespace != nil {
+			parentRef["namespace"] = *ref.Namespace
+		}
+		if ref.SectionName != nil {
+			parentRef["sectionName"] = *ref.SectionName
+		}
+		parentRefs = append(parentRefs, parentRef)
+	}
+
+	route := ea.httpRoute.Build()
+	route.Object["spec"] = map[string]interface{}{
+		"parentRefs": parentRefs,
+		"hostnames":  []interface{}{ea.spec.Hostname},
+		"rules": []interface{}{
+			map[string]interface{}{
+				"backendRefs": []interface{}{
+					map[string]interface{}{
+						"name": ea.serviceName,
+						"port": int64(ea.servicePort),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (ea *externalAccess) needSync() bool {
+	if ea.needIngress() {
+		_ = ea.buildIngress()
+		if ea.ingress.NeedSync() {
+			return true
+		}
+	} else if needRemoval(ea.ingress, ea.labeller) {
+		return true
+	}
+
+	if ea.needHTTPRoute() {
+		ea.buildHTTPRoute()
+		return ea.httpRoute.NeedSync()
+	}
+	return needRemoval(ea.httpRoute, ea.labeller)
+}
+
+func (ea *externalAccess) Sync(ctx context.Context) error {
+	if ea.needIngress() {
+		_ = ea.buildIngress()
+		if err := ea.ingress.Sync(ctx); err != nil {
+			return err
+		}
+	} else if err := removeObject(ctx, ea.ingress, ea.labeller); err != nil {
+		return err
+	}
+
+	if ea.needHTTPRoute() {
+		ea.buildHTTPRoute()
+		return ea.httpRoute.Sync(ctx)
+	}
+	return removeObject(ctx, ea.httpRoute, ea.labeller)
+}
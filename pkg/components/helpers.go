@@ -1,14 +1,22 @@
 package components
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
 	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/labeller"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/resources"
 	"go.ytsaurus.tech/library/go/ptr"
 	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yson"
 	"go.ytsaurus.tech/yt/go/yt"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/strings/slices"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
@@ -135,3 +143,153 @@ func handleUpdatingClusterState(
 	}
 	return nil, err
 }
+
+// appendReleasedImage moves the image to the end of the release history.
+func appendReleasedImage(images []string, image string) []string {
+	result := make([]string, 0, len(images)+1)
+	for _, i := range images {
+		if i != image {
+			result = append(result, i)
+		}
+	}
+	return append(result, image)
+}
+
+// setSyncedCondition reports the result of syncing Cypress objects of a standalone resource.
+func setSyncedCondition(conditionManager apiproxy.ConditionManager, err error) {
+	if err != nil {
+		conditionManager.SetStatusCondition(metav1.Condition{
+			Type:    consts.ConditionSynced,
+			Status:  metav1.ConditionFalse,
+			Reason:  "SyncFailed",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	conditionManager.SetStatusCondition(metav1.Condition{
+		Type:    consts.ConditionSynced,
+		Status:  metav1.ConditionTrue,
+		Reason:  "Synced",
+		Message: "Cypress objects are in sync with the spec",
+	})
+}
+
+// normalizeNodeValue converts the value to the form which doesn't depend on its Go types.
+// The value is decoded from YSON in the same way as node values are, and then formatted as JSON,
+// which sorts map keys and formats int64, uint64 and float64 numbers equally.
+func normalizeNodeValue(value interface{}) ([]byte, error) {
+	data, err := yson.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var decoded interface{}
+	if err = yson.Unmarshal(data, &decoded); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(decoded)
+}
+
+// isEqualNodeValue compares the value of a Cypress node with the expected one.
+func isEqualNodeValue(actual, expected interface{}) (bool, error) {
+	normalizedActual, err := normalizeNodeValue(actual)
+	if err != nil {
+		return false, err
+	}
+
+	normalizedExpected, err := normalizeNodeValue(expected)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(normalizedActual, normalizedExpected), nil
+}
+
+// removeCypressObject removes the object if it exists, builtin objects are kept.
+// It reports if the object was removed.
+func removeCypressObject(ctx context.Context, ytClient yt.Client, path ypath.Path, options *yt.RemoveNodeOptions) (bool, error) {
+	exists, err := ytClient.NodeExists(ctx, path, nil)
+	if err != nil || !exists {
+		return false, err
+	}
+
+	// Only objects like users, groups and accounts have the attribute.
+	hasBuiltin, err := ytClient.NodeExists(ctx, path.Attr("builtin"), nil)
+	if err != nil {
+		return false, err
+	}
+	if hasBuiltin {
+		var builtin bool
+		if err = ytClient.GetNode(ctx, path.Attr("builtin"), &builtin, nil); err != nil {
+			return false, err
+		}
+		if builtin {
+			log.FromContext(ctx).Info("Builtin object is not removed", "path", path.String())
+			return false, nil
+		}
+	}
+
+	log.FromContext(ctx).Info("Removing object", "path", path.String())
+	return true, ytClient.RemoveNode(ctx, path, options)
+}
+
+// mergeNodeValue puts the keys of the value on top of the current map value, so the keys set by others are kept.
+func mergeNodeValue(current interface{}, value map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{})
+	if currentMap, ok := current.(map[string]interface{}); ok {
+		for key, item := range currentMap {
+			merged[key] = item
+		}
+	}
+	for key, item := range value {
+		merged[key] = item
+	}
+	return merged
+}
+
+// syncNodeAttribute sets the attribute if its current value differs from the expected one.
+func syncNodeAttribute(ctx context.Context, ytClient yt.Client, path ypath.Path, value interface{}) error {
+	exists, err := ytClient.NodeExists(ctx, path, nil)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		var current interface{}
+		if err = ytClient.GetNode(ctx, path, &current, nil); err != nil {
+			return err
+		}
+
+		equal, err := isEqualNodeValue(current, value)
+		if err != nil {
+			return err
+		}
+		if equal {
+			return nil
+		}
+	}
+
+	log.FromContext(ctx).Info("Setting attribute", "path", path.String(), "value", value)
+	return ytClient.SetNode(ctx, path, value, nil)
+}
+
+// isControlledByCluster checks that the object was created by the operator for the cluster,
+// so objects with the same name created by others are never removed.
+func isControlledByCluster(object client.Object, l *labeller.Labeller) bool {
+	owner := metav1.GetControllerOf(object)
+	return owner != nil && owner.UID == l.ObjectMeta.UID
+}
+
+// needRemoval checks if the object is left from the spec fields, which are unset now.
+func needRemoval(resource resources.Resource, l *labeller.Labeller) bool {
+	return resources.Exists(resource) && isControlledByCluster(resource.OldObject(), l)
+}
+
+func removeObject(ctx context.Context, resource resources.Resource, l *labeller.Labeller) error {
+	if !needRemoval(resource, l) {
+		return nil
+	}
+	return l.APIProxy.DeleteObject(ctx, resource.OldObject())
+}
@@ -2,6 +2,7 @@ package components
 
 import (
 	"context"
+	"fmt"
 	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
 	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
 	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
@@ -19,9 +20,11 @@ type httpProxy struct {
 	serviceType      corev1.ServiceType
 	master           Component
 	balancingService *resources.HTTPService
+	externalAccess   *externalAccess
 
 	role        string
 	httpsSecret *resources.TLSSecret
+	certificate *tlsCertificate
 
 	ytClient yt.Client
 }
@@ -53,31 +56,87 @@ func NewHTTPProxy(
 			return cfgen.GetHTTPProxyConfig(spec)
 		},
 	)
+	if !spec.Transport.DisableHTTP {
+		server.addPublicPorts(consts.HTTPProxyHTTPPort, consts.HTTPProxyHTTPPort)
+	}
+	if spec.Transport.HTTPSSecret != nil {
+		server.addPublicPorts(consts.HTTPProxyHTTPSPort, consts.HTTPProxyHTTPSPort)
+	}
 
 	var httpsSecret *resources.TLSSecret
 	if spec.Transport.HTTPSSecret != nil {
 		httpsSecret = resources.NewTLSSecret(
 			spec.Transport.HTTPSSecret.Name,
 			consts.HTTPSSecretVolumeName,
-			consts.HTTPSSecretMountPoint)
+			consts.HTTPSSecretMountPoint,
+			ytsaurus.APIProxy())
+	}
+
+	// The certificate is issued into the secret, so it is known only if the secret is set.
+	var certificate *tlsCertificate
+	if spec.Transport.HTTPSSecret != nil {
+		certificate = newTLSCertificate(
+			spec.Transport.HTTPSSecret.Name,
+			spec.Transport.HTTPSCertificate,
+			cfgen.GetHTTPProxiesDNSNames(spec.Role),
+			&l)
 	}
 
+	port := int32(consts.HTTPProxyHTTPPort)
+	if spec.Transport.DisableHTTP {
+		port = consts.HTTPProxyHTTPSPort
+	}
+	ea := newExternalAccess(
+		spec.ExternalAccess,
+		cfgen.GetHTTPProxiesServiceName(spec.Role),
+		port,
+		&l,
+		ytsaurus.APIProxy())
+
 	return &httpProxy{
 		componentBase: componentBase{
 			labeller: &l,
 			ytsaurus: ytsaurus,
 			cfgen:    cfgen,
 		},
-		server:      server,
-		master:      masterReconciler,
-		serviceType: spec.ServiceType,
-		role:        spec.Role,
-		httpsSecret: httpsSecret,
+		server:         server,
+		master:         masterReconciler,
+		serviceType:    spec.ServiceType,
+		role:           spec.Role,
+		httpsSecret:    httpsSecret,
+		certificate:    certificate,
+		externalAccess: ea,
 		balancingService: resources.NewHTTPService(
 			cfgen.GetHTTPProxiesServiceName(spec.Role),
 			&spec.Transport,
 			&l,
-			ytsaurus.APIProxy()),
+			ytsaurus.APIProxy(),
+			&resource.Spec.CommonSpec),
+	}
+}
+
+// addAuthenticationSecrets mounts the secrets of the external authentication, the proxy config refers to their files.
+func addAuthenticationSecrets(podSpec *corev1.PodSpec, auth *ytv1.AuthenticationSpec) {
+	if auth == nil {
+		return
+	}
+
+	container := &podSpec.Containers[0]
+	if auth.Oidc != nil {
+		podSpec.Volumes = append(podSpec.Volumes, createSecretKeyVolume(consts.OidcSecretVolumeName, auth.Oidc.ClientSecret))
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      consts.OidcSecretVolumeName,
+			MountPath: consts.OidcSecretMountPoint,
+			ReadOnly:  true,
+		})
+	}
+	if auth.Ldap != nil {
+		podSpec.Volumes = append(podSpec.Volumes, createSecretKeyVolume(consts.LdapSecretVolumeName, auth.Ldap.BindPassword))
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      consts.LdapSecretVolumeName,
+			MountPath: consts.LdapSecretMountPoint,
+			ReadOnly:  true,
+		})
 	}
 }
 
@@ -86,10 +145,18 @@ func (hp *httpProxy) IsUpdatable() bool {
 }
 
 func (hp *httpProxy) Fetch(ctx context.Context) error {
-	return resources.Fetch(ctx, []resources.Fetchable{
+	fetchable := []resources.Fetchable{
 		hp.server,
 		hp.balancingService,
-	})
+		hp.externalAccess,
+	}
+	if hp.certificate != nil {
+		fetchable = append(fetchable, hp.certificate)
+	}
+	if hp.httpsSecret != nil {
+		fetchable = append(fetchable, hp.httpsSecret)
+	}
+	return resources.Fetch(ctx, fetchable)
 }
 
 func (hp *httpProxy) doSync(ctx context.Context, dry bool) (ComponentStatus, error) {
@@ -109,13 +176,27 @@ func (hp *httpProxy) doSync(ctx context.Context, dry bool) (ComponentStatus, err
 		return WaitingStatus(SyncStatusBlocked, hp.master.GetName()), err
 	}
 
-	if hp.server.needSync() {
+	if hp.certificate != nil && hp.certificate.needSync() {
+		if !dry {
+			err = hp.certificate.Sync(ctx)
+		}
+		return WaitingStatus(SyncStatusPending, hp.certificate.Name()), err
+	}
+
+	if hp.server.needSync() || needTLSSecretRollout(hp.server, hp.httpsSecret) {
 		if !dry {
+			if !hp.server.needSync() {
+				hp.ytsaurus.APIProxy().RecordNormal(
+					"TLSSecretChanged",
+					fmt.Sprintf("Secret %s changed, rolling %s pods", hp.httpsSecret.SecretName, hp.GetName()))
+			}
 			statefulSet := hp.server.buildStatefulSet()
 			if hp.httpsSecret != nil {
 				hp.httpsSecret.AddVolume(&statefulSet.Spec.Template.Spec)
 				hp.httpsSecret.AddVolumeMount(&statefulSet.Spec.Template.Spec.Containers[0])
+				hp.httpsSecret.AddHashAnnotation(&statefulSet.Spec.Template)
 			}
+			addAuthenticationSecrets(&statefulSet.Spec.Template.Spec, hp.ytsaurus.GetResource().Spec.Authentication)
 			err = hp.server.Sync(ctx)
 		}
 		return WaitingStatus(SyncStatusPending, "components"), err
@@ -130,6 +211,13 @@ func (hp *httpProxy) doSync(ctx context.Context, dry bool) (ComponentStatus, err
 		return WaitingStatus(SyncStatusPending, hp.balancingService.Name()), err
 	}
 
+	if hp.externalAccess.needSync() {
+		if !dry {
+			err = hp.externalAccess.Sync(ctx)
+		}
+		return WaitingStatus(SyncStatusPending, hp.externalAccess.Name()), err
+	}
+
 	if !hp.server.arePodsReady(ctx) {
 		return WaitingStatus(SyncStatusBlocked, "pods"), err
 	}
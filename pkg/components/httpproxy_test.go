@@ -0,0 +1,359 @@
+package components
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/resources"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("HTTP proxy test", func() {
+	const hashAnnotation = consts.TLSSecretHashAnnotationPrefix + consts.HTTPSSecretVolumeName
+
+	var ytsaurusSpec *v1.Ytsaurus
+	var k8sClient client.Client
+	var scheme *runtime.Scheme
+	var secret *corev1.Secret
+
+	getCfgen := func() *ytconfig.Generator {
+		return ytconfig.NewGenerator(ytsaurusSpec, "cluster_domain")
+	}
+	getObjectName := func(name string) types.NamespacedName {
+		return types.NamespacedName{Name: name, Namespace: "default"}
+	}
+	statefulSetName := func() types.NamespacedName {
+		return getObjectName(getCfgen().GetHTTPProxiesStatefulSetName(consts.DefaultHTTPProxyRole))
+	}
+	serviceName := func() types.NamespacedName {
+		return getObjectName(getCfgen().GetHTTPProxiesServiceName(consts.DefaultHTTPProxyRole))
+	}
+
+	newHTTPProxy := func() *httpProxy {
+		ytsaurus := apiproxy.NewYtsaurus(ytsaurusSpec, k8sClient, record.NewFakeRecorder(10), scheme)
+		hp := NewHTTPProxy(getCfgen(), ytsaurus, NewFakeComponent("Master"), ytsaurusSpec.Spec.HTTPProxies[0]).(*httpProxy)
+		Expect(hp.Fetch(context.Background())).To(Succeed())
+		return hp
+	}
+
+	// syncHTTPProxy runs the sync steps until the proxy waits for its pods, which are never run here.
+	syncHTTPProxy := func() {
+		for i := 0; i < 10; i++ {
+			hp := newHTTPProxy()
+			if hp.Status(context.Background()).SyncStatus != SyncStatusPending {
+				return
+			}
+			Expect(hp.Sync(context.Background())).To(Succeed())
+		}
+		Fail("HTTP proxy is not synced")
+	}
+
+	getUnstructured := func(gvk schema.GroupVersionKind, name types.NamespacedName) (*unstructured.Unstructured, error) {
+		object := &unstructured.Unstructured{}
+		object.SetGroupVersionKind(gvk)
+		return object, k8sClient.Get(context.Background(), name, object)
+	}
+
+	getHashAnnotation := func() (string, bool) {
+		var statefulSet appsv1.StatefulSet
+		Expect(k8sClient.Get(context.Background(), statefulSetName(), &statefulSet)).To(Succeed())
+		hash, ok := statefulSet.Spec.Template.Annotations[hashAnnotation]
+		return hash, ok
+	}
+
+	BeforeEach(func() {
+		ytsaurusSpec = &v1.Ytsaurus{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ytsaurus",
+				Namespace: "default",
+				UID:       "ytsaurus-uid",
+			},
+			Spec: v1.YtsaurusSpec{
+				CommonSpec: v1.CommonSpec{
+					CoreImage: "ytsaurus/ytsaurus:latest",
+				},
+				PrimaryMasters: v1.MastersSpec{
+					InstanceSpec: v1.InstanceSpec{
+						InstanceCount: 1,
+					},
+				},
+				HTTPProxies: []v1.HTTPProxiesSpec{
+					{
+						Role: consts.DefaultHTTPProxyRole,
+						InstanceSpec: v1.InstanceSpec{
+							InstanceCount: 1,
+						},
+						Transport: v1.HTTPTransportSpec{
+							HTTPSSecret: &corev1.LocalObjectReference{Name: "https-secret"},
+						},
+					},
+				},
+			},
+		}
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "https-secret",
+				Namespace: "default",
+			},
+			Data: map[string][]byte{
+				corev1.TLSCertKey: []byte("old-certificate"),
+			},
+		}
+
+		scheme = runtime.NewScheme()
+		Expect(v1.AddToScheme(scheme)).To(Succeed())
+		Expect(appsv1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(networkingv1.AddToScheme(scheme)).To(Succeed())
+		// Objects of foreign kinds are handled as unstructured ones.
+		for _, gvk := range []schema.GroupVersionKind{resources.CertificateGVK, resources.HTTPRouteGVK} {
+			scheme.AddKnownTypeWithName(gvk, &unstructured.Unstructured{})
+			scheme.AddKnownTypeWithName(gvk.GroupVersion().WithKind(gvk.Kind+"List"), &unstructured.UnstructuredList{})
+		}
+		k8sClient = fake.NewClientBuilder().WithScheme(scheme).WithObjects(ytsaurusSpec, secret).Build()
+	})
+
+	It("Pods are rolled when the secret is renewed", func() {
+		ctx := context.Background()
+
+		hp := newHTTPProxy()
+		Expect(hp.Sync(ctx)).To(Succeed())
+		oldHash, ok := getHashAnnotation()
+		Expect(ok).Should(BeTrue())
+		Expect(oldHash).Should(Equal(hp.httpsSecret.Hash()))
+
+		hp = newHTTPProxy()
+		Expect(hp.server.needSync()).Should(BeFalse())
+		Expect(needTLSSecretRollout(hp.server, hp.httpsSecret)).Should(BeFalse())
+
+		secret.Data[corev1.TLSCertKey] = []byte("new-certificate")
+		Expect(k8sClient.Update(ctx, secret)).To(Succeed())
+
+		hp = newHTTPProxy()
+		Expect(hp.Status(ctx)).Should(Equal(WaitingStatus(SyncStatusPending, "components")))
+		Expect(hp.Sync(ctx)).To(Succeed())
+
+		newHash, ok := getHashAnnotation()
+		Expect(ok).Should(BeTrue())
+		Expect(newHash).ShouldNot(Equal(oldHash))
+		Expect(newHash).Should(Equal(hp.httpsSecret.Hash()))
+
+		hp = newHTTPProxy()
+		Expect(needTLSSecretRollout(hp.server, hp.httpsSecret)).Should(BeFalse())
+	})
+
+	It("Pods without the secret hash are not rolled", func() {
+		ctx := context.Background()
+
+		hp := newHTTPProxy()
+		Expect(hp.Sync(ctx)).To(Succeed())
+
+		var statefulSet appsv1.StatefulSet
+		Expect(k8sClient.Get(ctx, statefulSetName(), &statefulSet)).To(Succeed())
+		delete(statefulSet.Spec.Template.Annotations, hashAnnotation)
+		Expect(k8sClient.Update(ctx, &statefulSet)).To(Succeed())
+
+		hp = newHTTPProxy()
+		Expect(needTLSSecretRollout(hp.server, hp.httpsSecret)).Should(BeFalse())
+	})
+
+	It("Encryption mode step is rolled out without full update", func() {
+		ctx := context.Background()
+		ytsaurusSpec.Spec.InternalTransport = &v1.InternalTransportSpec{
+			EncryptionMode: v1.BusEncryptionModeRequired,
+			IssuerRef:      v1.CertificateIssuerReference{Name: "issuer"},
+		}
+		ytsaurusSpec.Status.State = v1.ClusterStateRunning
+		ytsaurusSpec.Status.InternalTransportEncryptionMode = v1.BusEncryptionModeDisabled
+		busSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      statefulSetName().Name + consts.BusSecretNameSuffix,
+				Namespace: "default",
+			},
+			Data: map[string][]byte{
+				corev1.TLSCertKey: []byte("bus-certificate"),
+			},
+		}
+		Expect(k8sClient.Create(ctx, busSecret)).To(Succeed())
+		syncHTTPProxy()
+
+		getTemplate := func() corev1.PodTemplateSpec {
+			var statefulSet appsv1.StatefulSet
+			Expect(k8sClient.Get(ctx, statefulSetName(), &statefulSet)).To(Succeed())
+			Expect(*statefulSet.Spec.Replicas).Should(Equal(int32(1)))
+			return statefulSet.Spec.Template
+		}
+		Expect(getTemplate().Annotations).Should(HaveKeyWithValue(consts.BusEncryptionModeAnnotation, "disabled"))
+		Expect(getTemplate().Spec.Volumes).ShouldNot(ContainElement(HaveField("Name", consts.BusSecretVolumeName)))
+
+		// The config changes with the step, but the pods are rolled by the stateful set.
+		ytsaurusSpec.Status.InternalTransportEncryptionMode = v1.BusEncryptionModeOptional
+		hp := newHTTPProxy()
+		Expect(hp.server.needUpdate()).Should(BeFalse())
+		Expect(hp.Status(ctx)).Should(Equal(WaitingStatus(SyncStatusPending, "components")))
+		syncHTTPProxy()
+
+		Expect(getTemplate().Annotations).Should(HaveKeyWithValue(consts.BusEncryptionModeAnnotation, "optional"))
+		Expect(getTemplate().Spec.Volumes).Should(ContainElement(HaveField("Name", consts.BusSecretVolumeName)))
+		hp = newHTTPProxy()
+		Expect(hp.server.needSync()).Should(BeFalse())
+
+		// Other config changes still require the update.
+		ytsaurusSpec.Spec.CoreImage = "ytsaurus/ytsaurus:new"
+		hp = newHTTPProxy()
+		Expect(hp.Status(ctx)).Should(Equal(SimpleStatus(SyncStatusNeedLocalUpdate)))
+	})
+
+	It("Secrets of the authentication are mounted for the proxy config", func() {
+		ctx := context.Background()
+		ytsaurusSpec.Spec.Authentication = &v1.AuthenticationSpec{
+			Oidc: &v1.OidcSpec{
+				Issuer:   "https://sso.example.com",
+				ClientID: "ytsaurus",
+				ClientSecret: corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "oidc"},
+					Key:                  "client-secret",
+				},
+			},
+		}
+		syncHTTPProxy()
+
+		var statefulSet appsv1.StatefulSet
+		Expect(k8sClient.Get(ctx, statefulSetName(), &statefulSet)).To(Succeed())
+		podSpec := statefulSet.Spec.Template.Spec
+		Expect(podSpec.Volumes).Should(ContainElement(createSecretKeyVolume(consts.OidcSecretVolumeName, ytsaurusSpec.Spec.Authentication.Oidc.ClientSecret)))
+		Expect(podSpec.Volumes).ShouldNot(ContainElement(HaveField("Name", consts.LdapSecretVolumeName)))
+		Expect(podSpec.Containers[0].VolumeMounts).Should(ContainElement(corev1.VolumeMount{
+			Name:      consts.OidcSecretVolumeName,
+			MountPath: consts.OidcSecretMountPoint,
+			ReadOnly:  true,
+		}))
+
+		hp := newHTTPProxy()
+		Expect(hp.server.needSync()).Should(BeFalse())
+	})
+
+	It("Ingress, HTTPRoute and Certificate are generated and removed with their spec", func() {
+		ctx := context.Background()
+		ingressClassName := "nginx"
+		spec := &ytsaurusSpec.Spec.HTTPProxies[0]
+		spec.Transport.HTTPSCertificate = &v1.CertificateSpec{
+			IssuerRef:     v1.CertificateIssuerReference{Name: "issuer", Kind: "ClusterIssuer"},
+			ExtraDNSNames: []string{"yt.example.com"},
+		}
+		spec.ExternalAccess = &v1.ExternalAccessSpec{
+			Hostname: "yt.example.com",
+			Ingress: &v1.IngressSpec{
+				IngressClassName: &ingressClassName,
+				TLSSecret:        &corev1.LocalObjectReference{Name: "ingress-secret"},
+			},
+			HTTPRoute: &v1.HTTPRouteSpec{
+				ParentRefs: []v1.GatewayReference{{Name: "gateway"}},
+			},
+		}
+		syncHTTPProxy()
+
+		var ingress networkingv1.Ingress
+		Expect(k8sClient.Get(ctx, serviceName(), &ingress)).To(Succeed())
+		Expect(ingress.Spec.IngressClassName).Should(Equal(&ingressClassName))
+		Expect(ingress.Spec.TLS).Should(Equal([]networkingv1.IngressTLS{
+			{Hosts: []string{"yt.example.com"}, SecretName: "ingress-secret"},
+		}))
+		Expect(ingress.Spec.Rules).Should(HaveLen(1))
+		Expect(ingress.Spec.Rules[0].Host).Should(Equal("yt.example.com"))
+		Expect(ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service).Should(Equal(&networkingv1.IngressServiceBackend{
+			Name: serviceName().Name,
+			Port: networkingv1.ServiceBackendPort{Number: consts.HTTPProxyHTTPPort},
+		}))
+
+		route, err := getUnstructured(resources.HTTPRouteGVK, serviceName())
+		Expect(err).To(Succeed())
+		Expect(route.Object["spec"]).Should(Equal(map[string]interface{}{
+			"parentRefs": []interface{}{
+				map[string]interface{}{"name": "gateway"},
+			},
+			"hostnames": []interface{}{"yt.example.com"},
+			"rules": []interface{}{
+				map[string]interface{}{
+					"backendRefs": []interface{}{
+						map[string]interface{}{
+							"name": serviceName().Name,
+							"port": int64(consts.HTTPProxyHTTPPort),
+						},
+					},
+				},
+			},
+		}))
+
+		certificate, err := getUnstructured(resources.CertificateGVK, getObjectName("https-secret"))
+		Expect(err).To(Succeed())
+		certificateSpec := certificate.Object["spec"].(map[string]interface{})
+		Expect(certificateSpec["secretName"]).Should(Equal("https-secret"))
+		Expect(certificateSpec["issuerRef"]).Should(Equal(map[string]interface{}{
+			"name": "issuer",
+			"kind": "ClusterIssuer",
+		}))
+		Expect(certificateSpec["dnsNames"]).Should(ContainElements(serviceName().Name, "yt.example.com"))
+
+		// The objects are removed one by one when their spec fields are unset.
+		spec.ExternalAccess.HTTPRoute = nil
+		syncHTTPProxy()
+		Expect(k8sClient.Get(ctx, serviceName(), &ingress)).To(Succeed())
+		_, err = getUnstructured(resources.HTTPRouteGVK, serviceName())
+		Expect(apierrors.IsNotFound(err)).Should(BeTrue())
+
+		spec.ExternalAccess = nil
+		spec.Transport.HTTPSCertificate = nil
+		syncHTTPProxy()
+		Expect(apierrors.IsNotFound(k8sClient.Get(ctx, serviceName(), &ingress))).Should(BeTrue())
+		_, err = getUnstructured(resources.CertificateGVK, getObjectName("https-secret"))
+		Expect(apierrors.IsNotFound(err)).Should(BeTrue())
+
+		hp := newHTTPProxy()
+		Expect(hp.externalAccess.needSync()).Should(BeFalse())
+		Expect(hp.certificate.needSync()).Should(BeFalse())
+	})
+
+	It("Objects created by others are not removed", func() {
+		ctx := context.Background()
+
+		certificate := &unstructured.Unstructured{}
+		certificate.SetGroupVersionKind(resources.CertificateGVK)
+		certificate.SetName("https-secret")
+		certificate.SetNamespace("default")
+		Expect(k8sClient.Create(ctx, certificate)).To(Succeed())
+
+		ingress := &networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      serviceName().Name,
+				Namespace: "default",
+			},
+		}
+		Expect(k8sClient.Create(ctx, ingress)).To(Succeed())
+
+		hp := newHTTPProxy()
+		Expect(hp.externalAccess.needSync()).Should(BeFalse())
+		Expect(hp.certificate.needSync()).Should(BeFalse())
+
+		syncHTTPProxy()
+		Expect(k8sClient.Get(ctx, serviceName(), ingress)).To(Succeed())
+		_, err := getUnstructured(resources.CertificateGVK, getObjectName("https-secret"))
+		Expect(err).To(Succeed())
+	})
+})
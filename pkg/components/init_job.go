@@ -43,6 +43,8 @@ type InitJob struct {
 	initCompletedCondition string
 
 	image string
+	// clusterName is used to label the pods, so they are allowed to connect to the cluster.
+	clusterName string
 
 	builtJob *batchv1.Job
 }
@@ -63,6 +65,7 @@ func NewInitJob(
 		imagePullSecrets:       imagePullSecrets,
 		initCompletedCondition: fmt.Sprintf("%s%sInitJobCompleted", name, labeller.ComponentName),
 		image:                  image,
+		clusterName:            labeller.GetClusterName(),
 		initJob: resources.NewJob(
 			labeller.GetInitJobName(name),
 			labeller,
@@ -84,6 +87,11 @@ func NewInitJob(
 	}
 }
 
+// SetClusterName sets the cluster of the job if it is not the owner of the job, e.g. for CHYT.
+func (j *InitJob) SetClusterName(clusterName string) {
+	j.clusterName = clusterName
+}
+
 func (j *InitJob) SetInitScript(script string) {
 	cm := j.configHelper.Build()
 	cm.Data[consts.InitClusterScriptFileName] = script
@@ -96,6 +104,9 @@ func (j *InitJob) Build() *batchv1.Job {
 	var defaultMode int32 = 0500
 	job := j.initJob.Build()
 	job.Spec.Template = corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: labeller.GetClusterLabelMap(j.clusterName),
+		},
 		Spec: corev1.PodSpec{
 			ImagePullSecrets: j.imagePullSecrets,
 			Containers: []corev1.Container{
@@ -129,13 +140,18 @@ func (j *InitJob) Sync(ctx context.Context, dry bool) (ComponentStatus, error) {
 	logger := log.FromContext(ctx)
 	var err error
 
-	if j.conditionsManager.IsStatusConditionTrue(j.initCompletedCondition) {
+	if j.isCompleted() {
 		return ComponentStatus{
 			SyncStatusReady,
 			fmt.Sprintf("%s completed", j.initJob.Name()),
 		}, err
 	}
 
+	// A previous job is being removed before the restart.
+	if resources.Exists(j.initJob) && j.initJob.OldObject().GetDeletionTimestamp() != nil {
+		return WaitingStatus(SyncStatusBlocked, fmt.Sprintf("%s removal", j.initJob.Name())), err
+	}
+
 	// Deal with init job.
 	if !resources.Exists(j.initJob) {
 		if !dry {
@@ -166,6 +182,10 @@ func (j *InitJob) Sync(ctx context.Context, dry bool) (ComponentStatus, error) {
 	return WaitingStatus(SyncStatusPending, fmt.Sprintf("setting %s condition", j.initCompletedCondition)), err
 }
 
+func (j *InitJob) isCompleted() bool {
+	return j.conditionsManager.IsStatusConditionTrue(j.initCompletedCondition)
+}
+
 func (j *InitJob) prepareRestart(ctx context.Context, dry bool) error {
 	if dry {
 		return nil
@@ -182,8 +202,12 @@ func (j *InitJob) prepareRestart(ctx context.Context, dry bool) error {
 	return nil
 }
 
+func (j *InitJob) isRestartRequested() bool {
+	return j.conditionsManager.IsStatusConditionFalse(j.initCompletedCondition)
+}
+
 func (j *InitJob) isRestartPrepared() bool {
-	return !resources.Exists(j.initJob) && j.conditionsManager.IsStatusConditionFalse(j.initCompletedCondition)
+	return !resources.Exists(j.initJob) && j.isRestartRequested()
 }
 
 func (j *InitJob) isRestartCompleted() bool {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"go.ytsaurus.tech/yt/go/yson"
+	"sort"
 	"strings"
 
 	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
@@ -22,6 +23,8 @@ type master struct {
 
 	initJob          *InitJob
 	adminCredentials corev1.Secret
+	// generatedAdminCredentials are used if the admin credentials are not set in spec.
+	generatedAdminCredentials *resources.StringSecret
 }
 
 func NewMaster(cfgen *ytconfig.Generator, ytsaurus *apiproxy.Ytsaurus) Component {
@@ -44,6 +47,9 @@ func NewMaster(cfgen *ytconfig.Generator, ytsaurus *apiproxy.Ytsaurus) Component
 		cfgen.GetMastersServiceName(),
 		cfgen.GetMasterConfig,
 	)
+	server.setHostAddresses(
+		resource.Spec.PrimaryMasters.HostAddresses,
+		resource.Spec.PrimaryMasters.HostAddressLabel)
 
 	initJob := NewInitJob(
 		&l,
@@ -55,14 +61,23 @@ func NewMaster(cfgen *ytconfig.Generator, ytsaurus *apiproxy.Ytsaurus) Component
 		resource.Spec.CoreImage,
 		cfgen.GetNativeClientConfig)
 
+	var generatedAdminCredentials *resources.StringSecret
+	if resource.Spec.AdminCredentials == nil {
+		generatedAdminCredentials = resources.NewStringSecret(
+			cfgen.GetAdminCredentialsSecretName(),
+			&l,
+			ytsaurus.APIProxy())
+	}
+
 	return &master{
 		componentBase: componentBase{
 			labeller: &l,
 			ytsaurus: ytsaurus,
 			cfgen:    cfgen,
 		},
-		server:  server,
-		initJob: initJob,
+		server:                    server,
+		initJob:                   initJob,
+		generatedAdminCredentials: generatedAdminCredentials,
 	}
 }
 
@@ -81,32 +96,82 @@ func (m *master) Fetch(ctx context.Context) error {
 		}
 	}
 
-	return resources.Fetch(ctx, []resources.Fetchable{
+	fetchable := []resources.Fetchable{
 		m.server,
 		m.initJob,
-	})
+	}
+	if m.generatedAdminCredentials != nil {
+		fetchable = append(fetchable, m.generatedAdminCredentials)
+	}
+	return resources.Fetch(ctx, fetchable)
 }
 
-func (m *master) initAdminUser() string {
-	adminLogin, adminPassword := consts.DefaultAdminLogin, consts.DefaultAdminPassword
-	adminToken := consts.DefaultAdminPassword
+func (m *master) getAdminCredentials() map[string][]byte {
+	if m.generatedAdminCredentials != nil {
+		return m.generatedAdminCredentials.OldObject().(*corev1.Secret).Data
+	}
+	return m.adminCredentials.Data
+}
 
-	if m.adminCredentials.Name != "" {
-		value, ok := m.adminCredentials.Data[consts.AdminLoginSecret]
-		if ok {
-			adminLogin = string(value)
-		}
-		value, ok = m.adminCredentials.Data[consts.AdminPasswordSecret]
-		if ok {
-			adminPassword = string(value)
+// syncGeneratedAdminCredentials creates the secret with random admin credentials.
+// Clusters which have been initialized already keep their credentials until the init job is restarted.
+func (m *master) syncGeneratedAdminCredentials(ctx context.Context, dry bool) (*ComponentStatus, error) {
+	if m.generatedAdminCredentials == nil || m.initJob.isCompleted() {
+		return nil, nil
+	}
+
+	secret := m.generatedAdminCredentials
+	if secret.NeedSync(consts.AdminPasswordSecret, "") || secret.NeedSync(consts.AdminTokenSecret, "") {
+		var err error
+		if !dry {
+			err = m.createAdminCredentials(ctx)
 		}
+		status := WaitingStatus(SyncStatusPending, secret.Name())
+		return &status, err
+	}
 
-		value, ok = m.adminCredentials.Data[consts.AdminTokenSecret]
-		if ok {
-			adminToken = string(value)
+	if m.ytsaurus.GetResource().Status.AdminCredentialsSecret != secret.Name() {
+		var err error
+		if !dry {
+			err = m.ytsaurus.SaveAdminCredentialsSecret(ctx, secret.Name())
 		}
+		status := WaitingStatus(SyncStatusPending, "admin credentials status")
+		return &status, err
 	}
 
+	return nil, nil
+}
+
+func (m *master) createAdminCredentials(ctx context.Context) error {
+	password, err := ytconfig.RandSecureString(30)
+	if err != nil {
+		return err
+	}
+	token, err := ytconfig.RandSecureString(30)
+	if err != nil {
+		return err
+	}
+
+	s := m.generatedAdminCredentials.Build()
+	s.StringData = map[string]string{
+		consts.AdminLoginSecret:    consts.DefaultAdminLogin,
+		consts.AdminPasswordSecret: password,
+		consts.AdminTokenSecret:    token,
+	}
+	return m.generatedAdminCredentials.Sync(ctx)
+}
+
+func (m *master) initAdminUser() string {
+	credentials := m.getAdminCredentials()
+
+	adminLogin := consts.DefaultAdminLogin
+	if value, ok := credentials[consts.AdminLoginSecret]; ok {
+		adminLogin = string(value)
+	}
+	// Password and token are not set if they are missing in the secret.
+	adminPassword := string(credentials[consts.AdminPasswordSecret])
+	adminToken := string(credentials[consts.AdminTokenSecret])
+
 	commands := createUserCommand(adminLogin, adminPassword, adminToken, true)
 	return strings.Join(commands, "\n")
 }
@@ -115,10 +180,10 @@ type Medium struct {
 	Name string `yson:"name"`
 }
 
-func (m *master) getExtraMedia() []Medium {
+func getExtraMedia(resource *ytv1.Ytsaurus) []Medium {
 	mediaMap := make(map[string]Medium)
 
-	for _, d := range m.ytsaurus.GetResource().Spec.DataNodes {
+	for _, d := range resource.Spec.DataNodes {
 		for _, l := range d.Locations {
 			if l.Medium == consts.DefaultMedium {
 				continue
@@ -133,13 +198,16 @@ func (m *master) getExtraMedia() []Medium {
 	for _, v := range mediaMap {
 		mediaSlice = append(mediaSlice, v)
 	}
+	sort.Slice(mediaSlice, func(i, j int) bool {
+		return mediaSlice[i].Name < mediaSlice[j].Name
+	})
 
 	return mediaSlice
 }
 
 func (m *master) initMedia() string {
 	commands := []string{}
-	for _, medium := range m.getExtraMedia() {
+	for _, medium := range getExtraMedia(m.ytsaurus.GetResource()) {
 		attr, err := yson.MarshalFormat(medium, yson.FormatText)
 		if err != nil {
 			panic(err)
@@ -195,6 +263,10 @@ func (m *master) doSync(ctx context.Context, dry bool) (ComponentStatus, error)
 		return WaitingStatus(SyncStatusBlocked, "pods"), err
 	}
 
+	if status, err := m.syncGeneratedAdminCredentials(ctx, dry); status != nil {
+		return *status, err
+	}
+
 	if !dry {
 		m.initJob.SetInitScript(m.createInitScript())
 	}
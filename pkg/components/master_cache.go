@@ -0,0 +1,104 @@
+package components
+
+import (
+	"context"
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/labeller"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/resources"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
+)
+
+type masterCache struct {
+	componentBase
+	server server
+	master Component
+}
+
+func NewMasterCache(cfgen *ytconfig.Generator, ytsaurus *apiproxy.Ytsaurus, master Component) Component {
+	resource := ytsaurus.GetResource()
+	l := labeller.Labeller{
+		ObjectMeta:     &resource.ObjectMeta,
+		APIProxy:       ytsaurus.APIProxy(),
+		ComponentLabel: consts.YTComponentLabelMasterCache,
+		ComponentName:  "MasterCache",
+		MonitoringPort: consts.MasterCacheMonitoringPort,
+	}
+
+	server := newServer(
+		&l,
+		ytsaurus,
+		&resource.Spec.MasterCaches.InstanceSpec,
+		"/usr/bin/ytserver-master-cache",
+		"ytserver-master-cache.yson",
+		cfgen.GetMasterCachesStatefulSetName(),
+		cfgen.GetMasterCachesServiceName(),
+		cfgen.GetMasterCacheConfig,
+	)
+
+	return &masterCache{
+		componentBase: componentBase{
+			labeller: &l,
+			ytsaurus: ytsaurus,
+			cfgen:    cfgen,
+		},
+		server: server,
+		master: master,
+	}
+}
+
+func (mc *masterCache) IsUpdatable() bool {
+	return true
+}
+
+func (mc *masterCache) Fetch(ctx context.Context) error {
+	return resources.Fetch(ctx, []resources.Fetchable{
+		mc.server,
+	})
+}
+
+func (mc *masterCache) doSync(ctx context.Context, dry bool) (ComponentStatus, error) {
+	var err error
+
+	if mc.ytsaurus.GetClusterState() == ytv1.ClusterStateRunning && mc.server.needUpdate() {
+		return SimpleStatus(SyncStatusNeedLocalUpdate), err
+	}
+
+	if mc.ytsaurus.GetClusterState() == ytv1.ClusterStateUpdating {
+		if status, err := handleUpdatingClusterState(ctx, mc.ytsaurus, mc, &mc.componentBase, mc.server, dry); status != nil {
+			return *status, err
+		}
+	}
+
+	if mc.master.Status(ctx).SyncStatus != SyncStatusReady {
+		return WaitingStatus(SyncStatusBlocked, mc.master.GetName()), err
+	}
+
+	if mc.server.needSync() {
+		if !dry {
+			err = mc.server.Sync(ctx)
+		}
+		return WaitingStatus(SyncStatusPending, "components"), err
+	}
+
+	if !mc.server.arePodsReady(ctx) {
+		return WaitingStatus(SyncStatusBlocked, "pods"), err
+	}
+
+	return SimpleStatus(SyncStatusReady), err
+}
+
+func (mc *masterCache) Status(ctx context.Context) ComponentStatus {
+	status, err := mc.doSync(ctx, true)
+	if err != nil {
+		panic(err)
+	}
+
+	return status
+}
+
+func (mc *masterCache) Sync(ctx context.Context) error {
+	_, err := mc.doSync(ctx, false)
+	return err
+}
@@ -26,6 +26,7 @@ type microservice interface {
 	buildDeployment() *appsv1.Deployment
 	buildService() *corev1.Service
 	buildConfig() *corev1.ConfigMap
+	getPodTemplate() *corev1.PodTemplateSpec
 }
 
 type microserviceImpl struct {
@@ -37,6 +38,8 @@ type microserviceImpl struct {
 	service      *resources.HTTPService
 	configHelper *ConfigHelper
 
+	networkPolicy *networkPolicy
+
 	builtDeployment *appsv1.Deployment
 	builtService    *corev1.Service
 	builtConfig     *corev1.ConfigMap
@@ -48,8 +51,8 @@ func newMicroservice(
 	image string,
 	instanceCount int32,
 	generators map[string]ytconfig.GeneratorDescriptor,
-	deploymentName, serviceName string) microservice {
-	return &microserviceImpl{
+	deploymentName, serviceName string) *microserviceImpl {
+	m := &microserviceImpl{
 		labeller:      labeller,
 		image:         image,
 		instanceCount: instanceCount,
@@ -57,7 +60,8 @@ func newMicroservice(
 			serviceName,
 			nil,
 			labeller,
-			ytsaurus.APIProxy()),
+			ytsaurus.APIProxy(),
+			&ytsaurus.GetResource().Spec.CommonSpec),
 		deployment: resources.NewDeployment(
 			deploymentName,
 			labeller,
@@ -69,6 +73,16 @@ func newMicroservice(
 			ytsaurus.GetResource().Spec.ConfigOverrides,
 			generators),
 	}
+
+	// The policy is created even if it is disabled, so that it is removed.
+	m.networkPolicy = newNetworkPolicy(ytsaurus.GetResource().Spec.NetworkPolicy, labeller)
+
+	return m
+}
+
+// addPublicPorts makes the ports reachable from outside of the cluster if network policies are enabled.
+func (m *microserviceImpl) addPublicPorts(port, endPort int32) {
+	m.networkPolicy.addPublicPorts(port, endPort)
 }
 
 func (m *microserviceImpl) Sync(ctx context.Context) (err error) {
@@ -76,25 +90,30 @@ func (m *microserviceImpl) Sync(ctx context.Context) (err error) {
 	_ = m.buildDeployment()
 	_ = m.buildService()
 
-	return resources.Sync(ctx, []resources.Syncable{
+	syncable := []resources.Syncable{
 		m.deployment,
 		m.configHelper,
 		m.service,
-	})
+		m.networkPolicy,
+	}
+	return resources.Sync(ctx, syncable)
 }
 
 func (m *microserviceImpl) Fetch(ctx context.Context) error {
-	return resources.Fetch(ctx, []resources.Fetchable{
+	fetchable := []resources.Fetchable{
 		m.configHelper,
 		m.deployment,
 		m.service,
-	})
+		m.networkPolicy,
+	}
+	return resources.Fetch(ctx, fetchable)
 }
 
 func (m *microserviceImpl) needSync() bool {
 	return m.configHelper.NeedSync() ||
 		!resources.Exists(m.service) ||
-		m.deployment.NeedSync(m.instanceCount)
+		m.deployment.NeedSync(m.instanceCount) ||
+		m.networkPolicy.needSync()
 }
 
 func (m *microserviceImpl) buildDeployment() *appsv1.Deployment {
@@ -167,6 +186,11 @@ func (m *microserviceImpl) podsImageCorrespondsToSpec() bool {
 	return m.deployment.OldObject().(*appsv1.Deployment).Spec.Template.Spec.Containers[0].Image == m.image
 }
 
+// getPodTemplate returns the pod template of the existing deployment.
+func (m *microserviceImpl) getPodTemplate() *corev1.PodTemplateSpec {
+	return &m.deployment.OldObject().(*appsv1.Deployment).Spec.Template
+}
+
 func (m *microserviceImpl) removePods(ctx context.Context) error {
 	m.builtDeployment = m.deployment.Build()
 	m.builtDeployment.Spec = m.deployment.OldObject().(*appsv1.Deployment).Spec
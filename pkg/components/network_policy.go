@@ -0,0 +1,117 @@
+package components
+
+import (
+	"context"
+
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/labeller"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/resources"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// networkPolicy restricts the ingress traffic of the component pods:
+// pods of the same cluster and its remote nodes may use any port, public ports are open to everyone
+// and the monitoring port is open to the namespaces from the spec.
+// The policy is removed if the spec is not set.
+type networkPolicy struct {
+	// spec is nil if network policies are disabled.
+	spec        *ytv1.NetworkPolicySpec
+	labeller    *labeller.Labeller
+	publicPorts []networkingv1.NetworkPolicyPort
+
+	policy *resources.NetworkPolicy
+}
+
+func newNetworkPolicy(spec *ytv1.NetworkPolicySpec, l *labeller.Labeller) *networkPolicy {
+	return &networkPolicy{
+		spec:     spec,
+		labeller: l,
+		policy:   resources.NewNetworkPolicy(l, l.APIProxy),
+	}
+}
+
+// addPublicPorts opens the TCP ports from port to endPort inclusively for everyone.
+func (np *networkPolicy) addPublicPorts(port, endPort int32) {
+	protocol := corev1.ProtocolTCP
+	policyPort := networkingv1.NetworkPolicyPort{
+		Protocol: &protocol,
+		Port:     &intstr.IntOrString{IntVal: port},
+	}
+	if endPort != port {
+		policyPort.EndPort = &endPort
+	}
+	np.publicPorts = append(np.publicPorts, policyPort)
+}
+
+func (np *networkPolicy) Fetch(ctx context.Context) error {
+	return np.policy.Fetch(ctx)
+}
+
+func (np *networkPolicy) build() *networkingv1.NetworkPolicy {
+	policy := np.policy.Build()
+
+	policy.Spec.Ingress = []networkingv1.NetworkPolicyIngressRule{
+		{
+			From: []networkingv1.NetworkPolicyPeer{
+				{
+					PodSelector: &metav1.LabelSelector{
+						MatchLabels: labeller.GetClusterLabelMap(np.labeller.GetClusterName()),
+					},
+				},
+				{
+					PodSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							consts.RemoteClusterLabelName: np.labeller.GetClusterName(),
+						},
+					},
+					NamespaceSelector: np.spec.RemoteNodesNamespaceSelector,
+				},
+			},
+		},
+	}
+
+	if len(np.publicPorts) != 0 {
+		policy.Spec.Ingress = append(policy.Spec.Ingress, networkingv1.NetworkPolicyIngressRule{
+			Ports: np.publicPorts,
+		})
+	}
+
+	if np.spec.MonitoringNamespaceSelector != nil && np.labeller.MonitoringPort != 0 {
+		protocol := corev1.ProtocolTCP
+		policy.Spec.Ingress = append(policy.Spec.Ingress, networkingv1.NetworkPolicyIngressRule{
+			From: []networkingv1.NetworkPolicyPeer{
+				{
+					NamespaceSelector: np.spec.MonitoringNamespaceSelector,
+				},
+			},
+			Ports: []networkingv1.NetworkPolicyPort{
+				{
+					Protocol: &protocol,
+					Port:     &intstr.IntOrString{IntVal: np.labeller.MonitoringPort},
+				},
+			},
+		})
+	}
+
+	return policy
+}
+
+func (np *networkPolicy) needSync() bool {
+	if np.spec == nil {
+		return needRemoval(np.policy, np.labeller)
+	}
+	_ = np.build()
+	return np.policy.NeedSync()
+}
+
+func (np *networkPolicy) Sync(ctx context.Context) error {
+	if np.spec == nil {
+		return removeObject(ctx, np.policy, np.labeller)
+	}
+	_ = np.build()
+	return np.policy.Sync(ctx)
+}
@@ -0,0 +1,201 @@
+package components
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/labeller"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/resources"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Network policy test", func() {
+	var ytsaurusSpec *v1.Ytsaurus
+	var k8sClient client.Client
+	var l *labeller.Labeller
+
+	tcp := corev1.ProtocolTCP
+	monitoringSelector := &metav1.LabelSelector{
+		MatchLabels: map[string]string{"monitoring": "true"},
+	}
+	policyName := types.NamespacedName{Name: "yt-http-proxy-network-policy", Namespace: "default"}
+
+	BeforeEach(func() {
+		ytsaurusSpec = &v1.Ytsaurus{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ytsaurus",
+				Namespace: "default",
+				UID:       "ytsaurus-uid",
+			},
+			Spec: v1.YtsaurusSpec{
+				PrimaryMasters: v1.MastersSpec{
+					InstanceSpec: v1.InstanceSpec{
+						InstanceCount: 1,
+					},
+				},
+				NetworkPolicy: &v1.NetworkPolicySpec{
+					MonitoringNamespaceSelector: monitoringSelector,
+				},
+			},
+		}
+
+		scheme := runtime.NewScheme()
+		Expect(v1.AddToScheme(scheme)).To(Succeed())
+		Expect(networkingv1.AddToScheme(scheme)).To(Succeed())
+		Expect(appsv1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		k8sClient = fake.NewClientBuilder().WithScheme(scheme).WithObjects(ytsaurusSpec).Build()
+		ytsaurus := apiproxy.NewYtsaurus(ytsaurusSpec, k8sClient, record.NewFakeRecorder(10), scheme)
+
+		l = &labeller.Labeller{
+			ObjectMeta:     &ytsaurusSpec.ObjectMeta,
+			APIProxy:       ytsaurus.APIProxy(),
+			ComponentLabel: consts.YTComponentLabelHTTPProxy,
+			ComponentName:  "HttpProxy",
+			MonitoringPort: consts.HTTPProxyMonitoringPort,
+		}
+	})
+
+	It("Cluster pods, remote nodes, public and monitoring ports are allowed", func() {
+		np := newNetworkPolicy(ytsaurusSpec.Spec.NetworkPolicy, l)
+		np.addPublicPorts(80, 80)
+		np.addPublicPorts(8000, 8100)
+		policy := np.build()
+
+		Expect(policy.Spec.PodSelector.MatchLabels).Should(Equal(l.GetSelectorLabelMap()))
+		Expect(policy.Spec.PolicyTypes).Should(Equal([]networkingv1.PolicyType{networkingv1.PolicyTypeIngress}))
+
+		endPort := int32(8100)
+		Expect(policy.Spec.Ingress).Should(Equal([]networkingv1.NetworkPolicyIngressRule{
+			{
+				From: []networkingv1.NetworkPolicyPeer{
+					{
+						PodSelector: &metav1.LabelSelector{
+							MatchLabels: labeller.GetClusterLabelMap("ytsaurus"),
+						},
+					},
+					{
+						PodSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{consts.RemoteClusterLabelName: "ytsaurus"},
+						},
+					},
+				},
+			},
+			{
+				Ports: []networkingv1.NetworkPolicyPort{
+					{Protocol: &tcp, Port: &intstr.IntOrString{IntVal: 80}},
+					{Protocol: &tcp, Port: &intstr.IntOrString{IntVal: 8000}, EndPort: &endPort},
+				},
+			},
+			{
+				From: []networkingv1.NetworkPolicyPeer{
+					{NamespaceSelector: monitoringSelector},
+				},
+				Ports: []networkingv1.NetworkPolicyPort{
+					{Protocol: &tcp, Port: &intstr.IntOrString{IntVal: consts.HTTPProxyMonitoringPort}},
+				},
+			},
+		}))
+	})
+
+	It("Remote nodes are allowed from the selected namespaces", func() {
+		remoteSelector := &metav1.LabelSelector{
+			MatchLabels: map[string]string{"remote-nodes": "true"},
+		}
+		ytsaurusSpec.Spec.NetworkPolicy.RemoteNodesNamespaceSelector = remoteSelector
+		policy := newNetworkPolicy(ytsaurusSpec.Spec.NetworkPolicy, l).build()
+
+		Expect(policy.Spec.Ingress[0].From[1].NamespaceSelector).Should(Equal(remoteSelector))
+	})
+
+	It("Policy is removed when network policies are disabled", func() {
+		ctx := context.Background()
+
+		np := newNetworkPolicy(ytsaurusSpec.Spec.NetworkPolicy, l)
+		Expect(np.Fetch(ctx)).To(Succeed())
+		Expect(np.needSync()).Should(BeTrue())
+		Expect(np.Sync(ctx)).To(Succeed())
+
+		var policy networkingv1.NetworkPolicy
+		Expect(k8sClient.Get(ctx, policyName, &policy)).To(Succeed())
+
+		np = newNetworkPolicy(ytsaurusSpec.Spec.NetworkPolicy, l)
+		Expect(np.Fetch(ctx)).To(Succeed())
+		Expect(np.needSync()).Should(BeFalse())
+
+		np = newNetworkPolicy(nil, l)
+		Expect(np.Fetch(ctx)).To(Succeed())
+		Expect(np.needSync()).Should(BeTrue())
+		Expect(np.Sync(ctx)).To(Succeed())
+
+		np = newNetworkPolicy(nil, l)
+		Expect(np.Fetch(ctx)).To(Succeed())
+		Expect(resources.Exists(np.policy)).Should(BeFalse())
+		Expect(np.needSync()).Should(BeFalse())
+	})
+
+	It("Policy not owned by the cluster is kept", func() {
+		ctx := context.Background()
+
+		policy := networkingv1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      policyName.Name,
+				Namespace: policyName.Namespace,
+			},
+		}
+		Expect(k8sClient.Create(ctx, &policy)).To(Succeed())
+
+		np := newNetworkPolicy(nil, l)
+		Expect(np.Fetch(ctx)).To(Succeed())
+		Expect(np.needSync()).Should(BeFalse())
+		Expect(np.Sync(ctx)).To(Succeed())
+
+		Expect(k8sClient.Get(ctx, policyName, &policy)).To(Succeed())
+	})
+
+	It("Pods of remote nodes are labeled with the cluster name", func() {
+		ctx := context.Background()
+
+		clusterConnection, err := ytconfig.NewGenerator(ytsaurusSpec, "cluster_domain").GetClusterConnection()
+		Expect(err).To(Succeed())
+		nodesSpec := &v1.RemoteExecNodes{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "remote",
+				Namespace: "default",
+			},
+			Spec: v1.RemoteExecNodesSpec{
+				ClusterConnection: string(clusterConnection),
+				CommonSpec: v1.CommonSpec{
+					CoreImage: "ytsaurus/ytsaurus:latest",
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, nodesSpec)).To(Succeed())
+
+		cfgen, err := ytconfig.NewRemoteNodeGenerator(nodesSpec.Name, &nodesSpec.Spec.CommonSpec, nil, clusterConnection)
+		Expect(err).To(Succeed())
+		nodes := NewRemoteExecNodes(cfgen, apiproxy.NewRemoteExecNodes(nodesSpec, k8sClient, record.NewFakeRecorder(10), k8sClient.Scheme()))
+		Expect(nodes.Fetch(ctx)).To(Succeed())
+		_, err = nodes.Sync(ctx)
+		Expect(err).To(Succeed())
+
+		var statefulSet appsv1.StatefulSet
+		name := types.NamespacedName{Name: cfgen.GetExecNodesStatefulSetName(), Namespace: "default"}
+		Expect(k8sClient.Get(ctx, name, &statefulSet)).To(Succeed())
+		Expect(statefulSet.Spec.Template.Labels).Should(HaveKeyWithValue(consts.RemoteClusterLabelName, "ytsaurus"))
+	})
+})
@@ -91,6 +91,10 @@ func (qt *queryTracker) IsUpdatable() bool {
 	return true
 }
 
+func (qt *queryTracker) getRobotToken() robotToken {
+	return newRobotToken("query_tracker", qt.secret)
+}
+
 func (qt *queryTracker) Fetch(ctx context.Context) error {
 	return resources.Fetch(ctx, []resources.Fetchable{
 		qt.server,
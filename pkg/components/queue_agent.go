@@ -0,0 +1,337 @@
+package components
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/labeller"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/resources"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
+	"go.ytsaurus.tech/library/go/ptr"
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type queueAgent struct {
+	componentBase
+	server server
+
+	ytsaurusClient YtsaurusClient
+	tabletNodes    []Component
+	initCondition  string
+	initQAState    *InitJob
+	secret         *resources.StringSecret
+}
+
+func NewQueueAgent(
+	cfgen *ytconfig.Generator,
+	ytsaurus *apiproxy.Ytsaurus,
+	yc YtsaurusClient,
+	tabletNodes []Component,
+) Component {
+	resource := ytsaurus.GetResource()
+	l := labeller.Labeller{
+		ObjectMeta:     &resource.ObjectMeta,
+		APIProxy:       ytsaurus.APIProxy(),
+		ComponentLabel: consts.YTComponentLabelQueueAgent,
+		ComponentName:  "QueueAgent",
+		MonitoringPort: consts.QueueAgentMonitoringPort,
+	}
+
+	server := newServer(
+		&l,
+		ytsaurus,
+		&resource.Spec.QueueAgents.InstanceSpec,
+		"/usr/bin/ytserver-queue-agent",
+		"ytserver-queue-agent.yson",
+		cfgen.GetQueueAgentStatefulSetName(),
+		cfgen.GetQueueAgentServiceName(),
+		cfgen.GetQueueAgentConfig,
+	)
+
+	image := ytsaurus.GetResource().Spec.CoreImage
+	if resource.Spec.QueueAgents.InstanceSpec.Image != nil {
+		image = *resource.Spec.QueueAgents.InstanceSpec.Image
+	}
+
+	return &queueAgent{
+		componentBase: componentBase{
+			labeller: &l,
+			ytsaurus: ytsaurus,
+			cfgen:    cfgen,
+		},
+		server:         server,
+		tabletNodes:    tabletNodes,
+		initCondition:  "queueAgentInitCompleted",
+		ytsaurusClient: yc,
+		initQAState: NewInitJob(
+			&l,
+			ytsaurus.APIProxy(),
+			ytsaurus,
+			resource.Spec.ImagePullSecrets,
+			"qa-state",
+			consts.ClientConfigFileName,
+			image,
+			cfgen.GetNativeClientConfig),
+		secret: resources.NewStringSecret(
+			l.GetSecretName(),
+			&l,
+			ytsaurus.APIProxy()),
+	}
+}
+
+func (qa *queueAgent) IsUpdatable() bool {
+	return true
+}
+
+func (qa *queueAgent) getRobotToken() robotToken {
+	return newRobotToken(consts.QueueAgentUserName, qa.secret)
+}
+
+func (qa *queueAgent) Fetch(ctx context.Context) error {
+	return resources.Fetch(ctx, []resources.Fetchable{
+		qa.server,
+		qa.initQAState,
+		qa.secret,
+	})
+}
+
+func (qa *queueAgent) doSync(ctx context.Context, dry bool) (ComponentStatus, error) {
+	var err error
+
+	if qa.ytsaurus.GetClusterState() == ytv1.ClusterStateRunning && qa.server.needUpdate() {
+		return SimpleStatus(SyncStatusNeedLocalUpdate), err
+	}
+
+	if qa.ytsaurus.GetClusterState() == ytv1.ClusterStateUpdating {
+		if IsUpdatingComponent(qa.ytsaurus, qa) {
+			if qa.ytsaurus.GetUpdateState() == ytv1.UpdateStateWaitingForPodsRemoval {
+				if !dry {
+					err = removePods(ctx, qa.server, &qa.componentBase)
+				}
+				return WaitingStatus(SyncStatusUpdating, "pods removal"), err
+			}
+
+			if status, err := qa.updateQAState(ctx, dry); status != nil {
+				return *status, err
+			}
+			if qa.ytsaurus.GetUpdateState() != ytv1.UpdateStateWaitingForPodsCreation &&
+				qa.ytsaurus.GetUpdateState() != ytv1.UpdateStateWaitingForQAStateUpdate {
+				return NewComponentStatus(SyncStatusReady, "Nothing to do now"), err
+			}
+		} else {
+			return NewComponentStatus(SyncStatusReady, "Not updating component"), err
+		}
+	}
+
+	if qa.secret.NeedSync(consts.TokenSecretKey, "") {
+		if !dry {
+			secretSpec := qa.secret.Build()
+			secretSpec.StringData = map[string]string{
+				consts.TokenSecretKey: ytconfig.RandString(30),
+			}
+			err = qa.secret.Sync(ctx)
+		}
+		return WaitingStatus(SyncStatusPending, qa.secret.Name()), err
+	}
+
+	if qa.server.needSync() {
+		if !dry {
+			err = qa.server.Sync(ctx)
+		}
+
+		return WaitingStatus(SyncStatusPending, "components"), err
+	}
+
+	if !qa.server.arePodsReady(ctx) {
+		return WaitingStatus(SyncStatusBlocked, "pods"), err
+	}
+
+	// Wait for tablet nodes to proceed with queue agent state init.
+	if len(qa.tabletNodes) == 0 {
+		return WaitingStatus(SyncStatusBlocked, "tablet nodes"), fmt.Errorf("cannot initialize queue agent without tablet nodes")
+	}
+	for _, tnd := range qa.tabletNodes {
+		if tnd.Status(ctx).SyncStatus != SyncStatusReady {
+			return WaitingStatus(SyncStatusBlocked, "tablet nodes"), err
+		}
+	}
+
+	var ytClient yt.Client
+	if qa.ytsaurus.GetClusterState() != ytv1.ClusterStateUpdating {
+		if qa.ytsaurusClient.Status(ctx).SyncStatus != SyncStatusReady {
+			return WaitingStatus(SyncStatusBlocked, qa.ytsaurusClient.GetName()), err
+		}
+
+		if !dry {
+			ytClient = qa.ytsaurusClient.GetYtClient()
+
+			token, _ := qa.secret.GetValue(consts.TokenSecretKey)
+			err = CreateUserCommand(ctx, ytClient, consts.QueueAgentUserName, token, true)
+			if err != nil {
+				return WaitingStatus(SyncStatusPending, "create qa user"), err
+			}
+		}
+	}
+
+	if !dry {
+		qa.prepareInitQueueAgentState()
+	}
+	status, err := qa.initQAState.Sync(ctx, dry)
+	if err != nil || status.SyncStatus != SyncStatusReady {
+		return status, err
+	}
+
+	if qa.ytsaurus.GetClusterState() != ytv1.ClusterStateUpdating {
+		if !dry {
+			err = qa.init(ctx, ytClient)
+			if err != nil {
+				return WaitingStatus(SyncStatusPending, fmt.Sprintf("%s initialization", qa.GetName())), err
+			}
+
+			qa.ytsaurus.SetStatusCondition(metav1.Condition{
+				Type:    qa.initCondition,
+				Status:  metav1.ConditionTrue,
+				Reason:  "InitQueueAgentCompleted",
+				Message: "Init queue agent successfully completed",
+			})
+		}
+	}
+
+	if qa.ytsaurus.IsStatusConditionTrue(qa.initCondition) {
+		return SimpleStatus(SyncStatusReady), err
+	}
+	return WaitingStatus(SyncStatusPending, fmt.Sprintf("setting %s condition", qa.initCondition)), err
+}
+
+func (qa *queueAgent) init(ctx context.Context, ytClient yt.Client) (err error) {
+	logger := log.FromContext(ctx)
+
+	err = ytClient.SetNode(
+		ctx,
+		ypath.Path("//sys/@cluster_connection/queue_agent"),
+		map[string]interface{}{
+			"stages": map[string]interface{}{
+				"production": map[string]interface{}{
+					"channel": map[string]interface{}{
+						"addresses": qa.cfgen.GetQueueAgentAddresses(),
+					},
+				},
+			},
+		},
+		nil,
+	)
+	if err != nil {
+		logger.Error(err, "Setting '//sys/@cluster_connection/queue_agent' failed")
+		return
+	}
+
+	clusterConnectionAttr := make(map[string]interface{})
+	err = ytClient.GetNode(ctx, ypath.Path("//sys/@cluster_connection"), &clusterConnectionAttr, nil)
+	if err != nil {
+		logger.Error(err, "Getting '//sys/@cluster_connection' failed")
+		return
+	}
+
+	// The entry may be registered by other components already, their options are kept.
+	clusterPath := ypath.Path("//sys/clusters").Child(qa.labeller.GetClusterName())
+	var cluster interface{}
+	exists, err := ytClient.NodeExists(ctx, clusterPath, nil)
+	if err == nil && exists {
+		err = ytClient.GetNode(ctx, clusterPath, &cluster, nil)
+	}
+	if err != nil {
+		logger.Error(err, fmt.Sprintf("Getting '%s' failed", clusterPath))
+		return
+	}
+
+	err = ytClient.SetNode(ctx, clusterPath, mergeNodeValue(cluster, clusterConnectionAttr), nil)
+	if err != nil {
+		logger.Error(err, fmt.Sprintf("Setting '%s' failed", clusterPath))
+		return
+	}
+	return
+}
+
+func (qa *queueAgent) Status(ctx context.Context) ComponentStatus {
+	status, err := qa.doSync(ctx, true)
+	if err != nil {
+		panic(err)
+	}
+
+	return status
+}
+
+func (qa *queueAgent) Sync(ctx context.Context) error {
+	_, err := qa.doSync(ctx, false)
+	return err
+}
+
+// createInitScript fails if the image has no init script, since the queue agent cannot work without its state.
+func (qa *queueAgent) createInitScript() string {
+	path := "/usr/bin/init_queue_agent_state"
+
+	script := []string{
+		initJobWithNativeDriverPrologue(),
+		fmt.Sprintf("%s --force --latest --proxy %s",
+			path, qa.cfgen.GetHTTPProxiesServiceAddress(consts.DefaultHTTPProxyRole)),
+	}
+
+	return strings.Join(script, "\n")
+}
+
+func (qa *queueAgent) prepareInitQueueAgentState() {
+	qa.initQAState.SetInitScript(qa.createInitScript())
+	job := qa.initQAState.Build()
+	container := &job.Spec.Template.Spec.Containers[0]
+	container.EnvFrom = []corev1.EnvFromSource{qa.secret.GetEnvSource()}
+}
+
+func (qa *queueAgent) updateQAState(ctx context.Context, dry bool) (*ComponentStatus, error) {
+	var err error
+	switch qa.ytsaurus.GetUpdateState() {
+	case ytv1.UpdateStateWaitingForQAStateUpdatingPrepare:
+		if !qa.initQAState.isRestartPrepared() {
+			return ptr.T(SimpleStatus(SyncStatusUpdating)), qa.initQAState.prepareRestart(ctx, dry)
+		}
+		if !dry {
+			qa.setConditionQAStatePreparedForUpdating()
+		}
+		return ptr.T(SimpleStatus(SyncStatusUpdating)), err
+	case ytv1.UpdateStateWaitingForQAStateUpdate:
+		if !qa.initQAState.isRestartCompleted() {
+			return nil, nil
+		}
+		if !dry {
+			qa.setConditionQAStateUpdated()
+		}
+		return ptr.T(SimpleStatus(SyncStatusUpdating)), err
+	default:
+		return nil, nil
+	}
+}
+
+func (qa *queueAgent) setConditionQAStatePreparedForUpdating() {
+	qa.ytsaurus.SetUpdateStatusCondition(metav1.Condition{
+		Type:    consts.ConditionQAStatePreparedForUpdating,
+		Status:  metav1.ConditionTrue,
+		Reason:  "QAStatePreparedForUpdating",
+		Message: "Queue agent state prepared for updating",
+	})
+}
+
+func (qa *queueAgent) setConditionQAStateUpdated() {
+	qa.ytsaurus.SetUpdateStatusCondition(metav1.Condition{
+		Type:    consts.ConditionQAStateUpdated,
+		Status:  metav1.ConditionTrue,
+		Reason:  "QAStateUpdated",
+		Message: "Queue agent state updated",
+	})
+}
@@ -0,0 +1,89 @@
+package components
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	mock_yt "github.com/ytsaurus/yt-k8s-operator/pkg/mock"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Queue agent test", func() {
+	var mockYtClient *mock_yt.MockClient
+	var qa *queueAgent
+
+	BeforeEach(func() {
+		mockYtClient = mock_yt.NewMockClient(ctrl)
+
+		ytsaurusSpec := &v1.Ytsaurus{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ytsaurus",
+				Namespace: "default",
+			},
+			Spec: v1.YtsaurusSpec{
+				QueueAgents: &v1.QueueAgentSpec{
+					InstanceSpec: v1.InstanceSpec{
+						InstanceCount: 1,
+					},
+				},
+			},
+		}
+
+		scheme := runtime.NewScheme()
+		Expect(v1.AddToScheme(scheme)).To(Succeed())
+		k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ytsaurusSpec).Build()
+
+		cfgen := ytconfig.NewGenerator(ytsaurusSpec, "cluster_domain")
+		ytsaurus := apiproxy.NewYtsaurus(ytsaurusSpec, k8sClient, record.NewFakeRecorder(10), scheme)
+		qa = NewQueueAgent(cfgen, ytsaurus, NewFakeYtsaurusClient(mockYtClient), nil).(*queueAgent)
+	})
+
+	It("State is initialized with the latest version by force", func() {
+		script := qa.createInitScript()
+
+		Expect(script).Should(ContainSubstring("/usr/bin/init_queue_agent_state --force --latest --proxy "))
+		Expect(script).ShouldNot(ContainSubstring("if [["))
+	})
+
+	It("Cluster entry is merged with the cluster connection", func() {
+		clusterPath := ypath.Path("//sys/clusters/ytsaurus")
+
+		mockYtClient.EXPECT().
+			SetNode(gomock.Any(), gomock.Eq(ypath.Path("//sys/@cluster_connection/queue_agent")), gomock.Any(), gomock.Nil()).
+			Return(nil)
+		mockYtClient.EXPECT().
+			GetNode(gomock.Any(), gomock.Eq(ypath.Path("//sys/@cluster_connection")), gomock.Any(), gomock.Nil()).
+			SetArg(2, map[string]interface{}{"cluster_name": "ytsaurus"}).
+			Return(nil)
+		mockYtClient.EXPECT().
+			NodeExists(gomock.Any(), gomock.Eq(clusterPath), gomock.Nil()).
+			Return(true, nil)
+		mockYtClient.EXPECT().
+			GetNode(gomock.Any(), gomock.Eq(clusterPath), gomock.Any(), gomock.Nil()).
+			DoAndReturn(func(_ context.Context, _ ypath.YPath, result interface{}, _ *yt.GetNodeOptions) error {
+				*result.(*interface{}) = map[string]interface{}{
+					"cluster_name":          "old",
+					"enable_native_tracing": true,
+				}
+				return nil
+			})
+		mockYtClient.EXPECT().
+			SetNode(gomock.Any(), gomock.Eq(clusterPath), gomock.Eq(map[string]interface{}{
+				"cluster_name":          "ytsaurus",
+				"enable_native_tracing": true,
+			}), gomock.Nil()).
+			Return(nil)
+
+		Expect(qa.init(context.Background(), mockYtClient)).Should(Succeed())
+	})
+})
@@ -0,0 +1,70 @@
+package components
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/labeller"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
+)
+
+type RemoteDataNodes struct {
+	remoteNodes
+	nodes *apiproxy.RemoteDataNodes
+}
+
+func NewRemoteDataNodes(cfgen *ytconfig.NodeGenerator, nodes *apiproxy.RemoteDataNodes) *RemoteDataNodes {
+	resource := nodes.GetResource()
+	l := labeller.Labeller{
+		ObjectMeta:     &resource.ObjectMeta,
+		APIProxy:       nodes.APIProxy(),
+		ComponentLabel: fmt.Sprintf("%s-%s", consts.YTComponentLabelRemoteDataNode, resource.Name),
+		ComponentName:  "RemoteDataNode",
+		MonitoringPort: consts.DataNodeMonitoringPort,
+	}
+
+	spec := resource.Spec.DataNodesSpec
+	server := newServerConfigured(
+		&l,
+		&resource.Spec.CommonSpec,
+		&resource.Spec.InstanceSpec,
+		"/usr/bin/ytserver-node",
+		"ytserver-data-node.yson",
+		cfgen.GetDataNodesStatefulSetName(),
+		cfgen.GetDataNodesServiceName(),
+		func() ([]byte, error) {
+			return cfgen.GetDataNodeConfig(spec)
+		},
+	)
+	if transport := resource.Spec.InternalTransport; transport != nil {
+		server.setInternalTransport(
+			transport,
+			cfgen.GetInternalTransportEncryptionMode(),
+			cfgen.GetDataNodesStatefulSetName(),
+			cfgen.GetDataNodesServiceName())
+	}
+
+	return &RemoteDataNodes{
+		remoteNodes: remoteNodes{
+			server:           server,
+			conditionManager: nodes,
+			apiProxy:         nodes.APIProxy(),
+			clusterName:      cfgen.GetClusterName(),
+			buildStatefulSet: func() error {
+				_ = server.buildStatefulSet()
+				return nil
+			},
+		},
+		nodes: nodes,
+	}
+}
+
+func (n *RemoteDataNodes) Sync(ctx context.Context) (bool, error) {
+	ready, err := n.remoteNodes.Sync(ctx)
+	if err == nil {
+		n.nodes.GetResource().Status.ObservedGeneration = n.nodes.GetResource().Generation
+	}
+	return ready, err
+}
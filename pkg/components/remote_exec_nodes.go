@@ -0,0 +1,69 @@
+package components
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/labeller"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
+)
+
+type RemoteExecNodes struct {
+	remoteNodes
+	nodes *apiproxy.RemoteExecNodes
+}
+
+func NewRemoteExecNodes(cfgen *ytconfig.NodeGenerator, nodes *apiproxy.RemoteExecNodes) *RemoteExecNodes {
+	resource := nodes.GetResource()
+	l := labeller.Labeller{
+		ObjectMeta:     &resource.ObjectMeta,
+		APIProxy:       nodes.APIProxy(),
+		ComponentLabel: fmt.Sprintf("%s-%s", consts.YTComponentLabelRemoteExecNode, resource.Name),
+		ComponentName:  "RemoteExecNode",
+		MonitoringPort: consts.ExecNodeMonitoringPort,
+	}
+
+	spec := resource.Spec.ExecNodesSpec
+	server := newServerConfigured(
+		&l,
+		&resource.Spec.CommonSpec,
+		&resource.Spec.InstanceSpec,
+		"/usr/bin/ytserver-node",
+		"ytserver-exec-node.yson",
+		cfgen.GetExecNodesStatefulSetName(),
+		cfgen.GetExecNodesServiceName(),
+		func() ([]byte, error) {
+			return cfgen.GetExecNodeConfig(spec)
+		},
+	)
+	if transport := resource.Spec.InternalTransport; transport != nil {
+		server.setInternalTransport(
+			transport,
+			cfgen.GetInternalTransportEncryptionMode(),
+			cfgen.GetExecNodesStatefulSetName(),
+			cfgen.GetExecNodesServiceName())
+	}
+
+	return &RemoteExecNodes{
+		remoteNodes: remoteNodes{
+			server:           server,
+			conditionManager: nodes,
+			apiProxy:         nodes.APIProxy(),
+			clusterName:      cfgen.GetClusterName(),
+			buildStatefulSet: func() error {
+				return setExecNodeContainers(server.buildStatefulSet(), spec.Privileged, spec.Sidecars)
+			},
+		},
+		nodes: nodes,
+	}
+}
+
+func (n *RemoteExecNodes) Sync(ctx context.Context) (bool, error) {
+	ready, err := n.remoteNodes.Sync(ctx)
+	if err == nil {
+		n.nodes.GetResource().Status.ObservedGeneration = n.nodes.GetResource().Generation
+	}
+	return ready, err
+}
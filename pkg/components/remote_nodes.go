@@ -0,0 +1,83 @@
+package components
+
+import (
+	"context"
+
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// remoteNodes manages the servers of a node group attached to a cluster by its connection.
+// There is no Ytsaurus update flow for them, so the pods are recreated as soon as
+// the image or the config changes.
+type remoteNodes struct {
+	server           server
+	conditionManager apiproxy.ConditionManager
+	apiProxy         apiproxy.APIProxy
+	// clusterName is put to the pod labels, so the network policies of the cluster let the pods in.
+	clusterName string
+
+	// buildStatefulSet customizes the stateful set of the node flavor.
+	buildStatefulSet func() error
+}
+
+func (rn *remoteNodes) Fetch(ctx context.Context) error {
+	return rn.server.Fetch(ctx)
+}
+
+func (rn *remoteNodes) doSync(ctx context.Context) (bool, error) {
+	if rn.server.needUpdate() {
+		rn.apiProxy.RecordNormal("Updating", "Image or config changed, recreating pods")
+		return false, rn.server.removePods(ctx)
+	}
+
+	if rn.server.needSync() || rn.server.getPodTemplate().Labels[consts.RemoteClusterLabelName] != rn.clusterName {
+		if err := rn.buildStatefulSet(); err != nil {
+			return false, err
+		}
+		rn.server.buildStatefulSet().Spec.Template.Labels[consts.RemoteClusterLabelName] = rn.clusterName
+		return false, rn.server.Sync(ctx)
+	}
+
+	return rn.server.arePodsReady(ctx), nil
+}
+
+// Sync returns true when all the pods are running.
+func (rn *remoteNodes) Sync(ctx context.Context) (bool, error) {
+	ready, err := rn.doSync(ctx)
+
+	if err != nil {
+		rn.conditionManager.SetStatusCondition(metav1.Condition{
+			Type:    consts.ConditionSynced,
+			Status:  metav1.ConditionFalse,
+			Reason:  "SyncFailed",
+			Message: err.Error(),
+		})
+	} else {
+		rn.conditionManager.SetStatusCondition(metav1.Condition{
+			Type:    consts.ConditionSynced,
+			Status:  metav1.ConditionTrue,
+			Reason:  "Synced",
+			Message: "Node resources are in sync with the spec",
+		})
+	}
+
+	if ready {
+		rn.conditionManager.SetStatusCondition(metav1.Condition{
+			Type:    consts.ConditionReady,
+			Status:  metav1.ConditionTrue,
+			Reason:  "PodsReady",
+			Message: "All the node pods are running",
+		})
+	} else {
+		rn.conditionManager.SetStatusCondition(metav1.Condition{
+			Type:    consts.ConditionReady,
+			Status:  metav1.ConditionFalse,
+			Reason:  "PodsNotReady",
+			Message: "Waiting for the node pods",
+		})
+	}
+
+	return ready, err
+}
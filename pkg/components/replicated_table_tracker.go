@@ -0,0 +1,146 @@
+package components
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/labeller"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/resources"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
+	"go.ytsaurus.tech/yt/go/yson"
+)
+
+type replicatedTableTracker struct {
+	componentBase
+	server          server
+	master          Component
+	initEnvironment *InitJob
+}
+
+func NewReplicatedTableTracker(cfgen *ytconfig.Generator, ytsaurus *apiproxy.Ytsaurus, master Component) Component {
+	resource := ytsaurus.GetResource()
+	l := labeller.Labeller{
+		ObjectMeta:     &resource.ObjectMeta,
+		APIProxy:       ytsaurus.APIProxy(),
+		ComponentLabel: consts.YTComponentLabelReplicatedTableTracker,
+		ComponentName:  "ReplicatedTableTracker",
+		MonitoringPort: consts.ReplicatedTableTrackerMonitoringPort,
+	}
+
+	server := newServer(
+		&l,
+		ytsaurus,
+		&resource.Spec.ReplicatedTableTrackers.InstanceSpec,
+		"/usr/bin/ytserver-replicated-table-tracker",
+		"ytserver-replicated-table-tracker.yson",
+		cfgen.GetReplicatedTableTrackerStatefulSetName(),
+		cfgen.GetReplicatedTableTrackerServiceName(),
+		cfgen.GetReplicatedTableTrackerConfig,
+	)
+
+	return &replicatedTableTracker{
+		componentBase: componentBase{
+			labeller: &l,
+			ytsaurus: ytsaurus,
+			cfgen:    cfgen,
+		},
+		server: server,
+		master: master,
+		initEnvironment: NewInitJob(
+			&l,
+			ytsaurus.APIProxy(),
+			ytsaurus,
+			resource.Spec.ImagePullSecrets,
+			"rtt-environment",
+			consts.ClientConfigFileName,
+			resource.Spec.CoreImage,
+			cfgen.GetNativeClientConfig),
+	}
+}
+
+func (rtt *replicatedTableTracker) IsUpdatable() bool {
+	return true
+}
+
+func (rtt *replicatedTableTracker) Fetch(ctx context.Context) error {
+	return resources.Fetch(ctx, []resources.Fetchable{
+		rtt.server,
+		rtt.initEnvironment,
+	})
+}
+
+func (rtt *replicatedTableTracker) createInitScript() string {
+	addresses, err := yson.MarshalFormat(rtt.cfgen.GetReplicatedTableTrackerAddresses(), yson.FormatText)
+	if err != nil {
+		panic(err)
+	}
+
+	script := []string{
+		initJobWithNativeDriverPrologue(),
+		// Switch masters from the embedded tracker to the standalone one.
+		"/usr/bin/yt set //sys/@config/tablet_manager/replicated_table_tracker/use_new_replicated_table_tracker %true -r -f",
+		fmt.Sprintf("/usr/bin/yt set //sys/@cluster_connection/replicated_table_tracker '{addresses=%s}'", string(addresses)),
+		fmt.Sprintf("/usr/bin/yt get //sys/@cluster_connection | /usr/bin/yt set //sys/clusters/%s", rtt.labeller.GetClusterName()),
+	}
+
+	return strings.Join(script, "\n")
+}
+
+func (rtt *replicatedTableTracker) doSync(ctx context.Context, dry bool) (ComponentStatus, error) {
+	var err error
+
+	if rtt.ytsaurus.GetClusterState() == ytv1.ClusterStateRunning && rtt.server.needUpdate() {
+		return SimpleStatus(SyncStatusNeedFullUpdate), err
+	}
+
+	if rtt.ytsaurus.GetClusterState() == ytv1.ClusterStateUpdating {
+		if status, err := handleUpdatingClusterState(ctx, rtt.ytsaurus, rtt, &rtt.componentBase, rtt.server, dry); status != nil {
+			return *status, err
+		}
+	}
+
+	if rtt.master.Status(ctx).SyncStatus != SyncStatusReady {
+		return WaitingStatus(SyncStatusBlocked, rtt.master.GetName()), err
+	}
+
+	if rtt.server.needSync() {
+		if !dry {
+			err = rtt.server.Sync(ctx)
+		}
+		return WaitingStatus(SyncStatusPending, "components"), err
+	}
+
+	if !rtt.server.arePodsReady(ctx) {
+		return WaitingStatus(SyncStatusBlocked, "pods"), err
+	}
+
+	// Masters are switched to the standalone tracker only when it is running,
+	// so replicated tables are tracked all the time.
+	if !dry {
+		rtt.initEnvironment.SetInitScript(rtt.createInitScript())
+	}
+	status, err := rtt.initEnvironment.Sync(ctx, dry)
+	if err != nil || status.SyncStatus != SyncStatusReady {
+		return status, err
+	}
+
+	return SimpleStatus(SyncStatusReady), err
+}
+
+func (rtt *replicatedTableTracker) Status(ctx context.Context) ComponentStatus {
+	status, err := rtt.doSync(ctx, true)
+	if err != nil {
+		panic(err)
+	}
+
+	return status
+}
+
+func (rtt *replicatedTableTracker) Sync(ctx context.Context) error {
+	_, err := rtt.doSync(ctx, false)
+	return err
+}
@@ -0,0 +1,129 @@
+package components
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Replicated table tracker test", func() {
+	var ytsaurusSpec *v1.Ytsaurus
+	var k8sClient client.Client
+	var scheme *runtime.Scheme
+
+	newReplicatedTableTracker := func() *replicatedTableTracker {
+		cfgen := ytconfig.NewGenerator(ytsaurusSpec, "cluster_domain")
+		ytsaurus := apiproxy.NewYtsaurus(ytsaurusSpec, k8sClient, record.NewFakeRecorder(10), scheme)
+		rtt := NewReplicatedTableTracker(cfgen, ytsaurus, NewFakeComponent("Master")).(*replicatedTableTracker)
+		Expect(rtt.Fetch(context.Background())).To(Succeed())
+		return rtt
+	}
+
+	// syncReplicatedTableTracker runs the sync steps until the tracker is blocked or ready.
+	syncReplicatedTableTracker := func() ComponentStatus {
+		for i := 0; i < 10; i++ {
+			rtt := newReplicatedTableTracker()
+			status := rtt.Status(context.Background())
+			if status.SyncStatus != SyncStatusPending {
+				return status
+			}
+			Expect(rtt.Sync(context.Background())).To(Succeed())
+		}
+		Fail("Replicated table tracker is not synced")
+		return ComponentStatus{}
+	}
+
+	getInitJobs := func() []batchv1.Job {
+		var jobs batchv1.JobList
+		Expect(k8sClient.List(context.Background(), &jobs)).To(Succeed())
+		return jobs.Items
+	}
+
+	BeforeEach(func() {
+		ytsaurusSpec = &v1.Ytsaurus{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ytsaurus",
+				Namespace: "default",
+			},
+			Spec: v1.YtsaurusSpec{
+				CommonSpec: v1.CommonSpec{
+					CoreImage: "ytsaurus/ytsaurus:latest",
+				},
+				PrimaryMasters: v1.MastersSpec{
+					InstanceSpec: v1.InstanceSpec{
+						InstanceCount: 1,
+					},
+				},
+				ReplicatedTableTrackers: &v1.ReplicatedTableTrackerSpec{
+					InstanceSpec: v1.InstanceSpec{
+						InstanceCount: 1,
+					},
+				},
+			},
+			Status: v1.YtsaurusStatus{
+				State: v1.ClusterStateRunning,
+			},
+		}
+
+		scheme = runtime.NewScheme()
+		Expect(v1.AddToScheme(scheme)).To(Succeed())
+		Expect(appsv1.AddToScheme(scheme)).To(Succeed())
+		Expect(batchv1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(networkingv1.AddToScheme(scheme)).To(Succeed())
+		k8sClient = fake.NewClientBuilder().WithScheme(scheme).WithObjects(ytsaurusSpec).Build()
+	})
+
+	It("Masters are switched to the tracker after its pods are ready", func() {
+		ctx := context.Background()
+
+		// The tracker is deployed, but masters keep the embedded tracker until its pods are ready.
+		status := syncReplicatedTableTracker()
+		Expect(status).Should(Equal(WaitingStatus(SyncStatusBlocked, "pods")))
+		Expect(getInitJobs()).Should(BeEmpty())
+
+		rtt := newReplicatedTableTracker()
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "rtt-ytsaurus-0",
+				Namespace: "default",
+				Labels:    rtt.labeller.GetSelectorLabelMap(),
+			},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodRunning,
+			},
+		}
+		Expect(k8sClient.Create(ctx, pod)).To(Succeed())
+
+		// The init job switches masters to the tracker.
+		status = syncReplicatedTableTracker()
+		Expect(status.SyncStatus).Should(Equal(SyncStatusBlocked))
+		jobs := getInitJobs()
+		Expect(jobs).Should(HaveLen(1))
+		script := rtt.createInitScript()
+		Expect(script).Should(ContainSubstring(
+			"/usr/bin/yt set //sys/@config/tablet_manager/replicated_table_tracker/use_new_replicated_table_tracker %true"))
+		Expect(script).Should(ContainSubstring(
+			"rtt-ytsaurus-0.replicated-table-trackers-ytsaurus.default.svc.cluster_domain:9033"))
+
+		job := jobs[0]
+		job.Status.Succeeded = 1
+		Expect(k8sClient.Status().Update(ctx, &job)).To(Succeed())
+
+		Expect(syncReplicatedTableTracker()).Should(Equal(SimpleStatus(SyncStatusReady)))
+		Expect(ytsaurusSpec.Status.Conditions).Should(ContainElement(HaveField("Type", "rtt-environmentReplicatedTableTrackerInitJobCompleted")))
+	})
+})
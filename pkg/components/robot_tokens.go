@@ -0,0 +1,253 @@
+package components
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/labeller"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/resources"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const defaultRobotTokenGracePeriod = time.Hour
+
+// robotToken is a token of a robot user, which is issued by a component and stored in its secret.
+type robotToken struct {
+	userName string
+	secret   *resources.StringSecret
+	// buildData returns the secret contents for the token.
+	buildData func(token string) map[string]string
+}
+
+func newRobotToken(userName string, secret *resources.StringSecret) robotToken {
+	return robotToken{
+		userName: userName,
+		secret:   secret,
+		buildData: func(token string) map[string]string {
+			return map[string]string{
+				consts.TokenSecretKey: token,
+			}
+		},
+	}
+}
+
+// robotTokenIssuer is implemented by the components which issue tokens for their robot users.
+type robotTokenIssuer interface {
+	getRobotToken() robotToken
+}
+
+func (t robotToken) getToken() string {
+	token, _ := t.secret.GetValue(consts.TokenSecretKey)
+	return token
+}
+
+// getIssueTime returns the time of the last rotation or the secret creation time,
+// if the token was never rotated.
+func (t robotToken) getIssueTime() time.Time {
+	issuedAt, err := time.Parse(time.RFC3339, t.secret.GetAnnotation(consts.RobotTokenIssuedAtAnnotation))
+	if err != nil {
+		return t.secret.OldObject().GetCreationTimestamp().Time
+	}
+	return issuedAt
+}
+
+func (t robotToken) buildSecret(token string, issuedAt time.Time, previousTokenHash string) {
+	s := t.secret.Build()
+	s.StringData = t.buildData(token)
+	s.Annotations = map[string]string{
+		consts.RobotTokenIssuedAtAnnotation: issuedAt.UTC().Format(time.RFC3339),
+	}
+	if previousTokenHash != "" {
+		s.Annotations[consts.RobotTokenPreviousHashAnnotation] = previousTokenHash
+	}
+}
+
+// addRobotTokenAnnotation records the token issue time in the pod template,
+// so the pods are rolled when the token is rotated.
+func addRobotTokenAnnotation(template *corev1.PodTemplateSpec, secret *resources.StringSecret) {
+	issuedAt := secret.GetAnnotation(consts.RobotTokenIssuedAtAnnotation)
+	if issuedAt == "" {
+		return
+	}
+	if template.Annotations == nil {
+		template.Annotations = map[string]string{}
+	}
+	template.Annotations[consts.RobotTokenIssuedAtAnnotation] = issuedAt
+}
+
+// needRobotTokenRollout checks if the pods were created before the token was rotated.
+func needRobotTokenRollout(template *corev1.PodTemplateSpec, secret *resources.StringSecret) bool {
+	return template.Annotations[consts.RobotTokenIssuedAtAnnotation] != secret.GetAnnotation(consts.RobotTokenIssuedAtAnnotation)
+}
+
+// robotTokens rotates tokens of the robot users on schedule or on demand.
+// The previous token is kept registered during the grace period,
+// so the pods which are being rolled can still use it.
+type robotTokens struct {
+	componentBase
+	ytsaurusClient YtsaurusClient
+	tokens         []robotToken
+}
+
+func NewRobotTokens(cfgen *ytconfig.Generator, ytsaurus *apiproxy.Ytsaurus, yc YtsaurusClient, components []Component) Component {
+	resource := ytsaurus.GetResource()
+	l := labeller.Labeller{
+		ObjectMeta:     &resource.ObjectMeta,
+		APIProxy:       ytsaurus.APIProxy(),
+		ComponentLabel: consts.YTComponentLabelRobotTokens,
+		ComponentName:  "RobotTokens",
+	}
+
+	var tokens []robotToken
+	for _, component := range components {
+		if issuer, ok := component.(robotTokenIssuer); ok {
+			tokens = append(tokens, issuer.getRobotToken())
+		}
+	}
+
+	return &robotTokens{
+		componentBase: componentBase{
+			labeller: &l,
+			ytsaurus: ytsaurus,
+			cfgen:    cfgen,
+		},
+		ytsaurusClient: yc,
+		tokens:         tokens,
+	}
+}
+
+func (rt *robotTokens) IsUpdatable() bool {
+	return false
+}
+
+func (rt *robotTokens) Fetch(ctx context.Context) error {
+	// Secrets are fetched by the components which issue the tokens.
+	return nil
+}
+
+func (rt *robotTokens) getGracePeriod() time.Duration {
+	spec := rt.ytsaurus.GetResource().Spec.RobotTokenRotation
+	if spec == nil || spec.GracePeriod == nil {
+		return defaultRobotTokenGracePeriod
+	}
+	return spec.GracePeriod.Duration
+}
+
+func (rt *robotTokens) needRotation(token robotToken, now time.Time) bool {
+	spec := rt.ytsaurus.GetResource().Spec.RobotTokenRotation
+	if spec == nil {
+		return false
+	}
+
+	issuedAt := token.getIssueTime()
+	if spec.RotateBefore != nil && !spec.RotateBefore.After(now) && issuedAt.Before(spec.RotateBefore.Time) {
+		return true
+	}
+	return spec.Period != nil && now.Sub(issuedAt) >= spec.Period.Duration
+}
+
+func (rt *robotTokens) needRevocation(token robotToken, now time.Time) bool {
+	return token.secret.GetAnnotation(consts.RobotTokenPreviousHashAnnotation) != "" &&
+		now.Sub(token.getIssueTime()) >= rt.getGracePeriod()
+}
+
+func (rt *robotTokens) rotateToken(ctx context.Context, ytClient yt.Client, token robotToken, now time.Time) error {
+	newToken, err := ytconfig.RandSecureString(30)
+	if err != nil {
+		return err
+	}
+
+	// The new token is registered before anyone gets it from the secret.
+	if err = CreateUserCommand(ctx, ytClient, token.userName, newToken, false); err != nil {
+		return err
+	}
+
+	token.buildSecret(newToken, now, sha256String(token.getToken()))
+	if err = token.secret.Sync(ctx); err != nil {
+		return err
+	}
+
+	log.FromContext(ctx).Info("Robot token was rotated", "user", token.userName)
+	rt.ytsaurus.APIProxy().RecordNormal(
+		"RobotTokenRotated",
+		fmt.Sprintf("Token of %s was rotated", token.userName))
+	return nil
+}
+
+func (rt *robotTokens) revokePreviousToken(ctx context.Context, ytClient yt.Client, token robotToken) error {
+	tokenHash := token.secret.GetAnnotation(consts.RobotTokenPreviousHashAnnotation)
+	tokenPath := ypath.Path(fmt.Sprintf("//sys/cypress_tokens/%s", tokenHash))
+	if err := ytClient.RemoveNode(ctx, tokenPath, &yt.RemoveNodeOptions{Force: true}); err != nil {
+		return err
+	}
+
+	token.buildSecret(token.getToken(), token.getIssueTime(), "")
+	if err := token.secret.Sync(ctx); err != nil {
+		return err
+	}
+
+	log.FromContext(ctx).Info("Previous robot token was revoked", "user", token.userName)
+	rt.ytsaurus.APIProxy().RecordNormal(
+		"RobotTokenRevoked",
+		fmt.Sprintf("Previous token of %s was revoked", token.userName))
+	return nil
+}
+
+func (rt *robotTokens) doSync(ctx context.Context, dry bool) (ComponentStatus, error) {
+	var err error
+
+	if rt.ytsaurus.GetClusterState() == ytv1.ClusterStateUpdating {
+		return NewComponentStatus(SyncStatusReady, "Not updating component"), err
+	}
+
+	if rt.ytsaurusClient.Status(ctx).SyncStatus != SyncStatusReady {
+		return WaitingStatus(SyncStatusBlocked, rt.ytsaurusClient.GetName()), err
+	}
+
+	now := time.Now()
+	for _, token := range rt.tokens {
+		// Tokens are initially issued by their components.
+		if !resources.Exists(token.secret) || token.getToken() == "" {
+			continue
+		}
+
+		if rt.needRevocation(token, now) {
+			if !dry {
+				err = rt.revokePreviousToken(ctx, rt.ytsaurusClient.GetYtClient(), token)
+			}
+			return WaitingStatus(SyncStatusPending, fmt.Sprintf("%s revocation", token.secret.Name())), err
+		}
+
+		// The previous token must be revoked before the next rotation.
+		if token.secret.GetAnnotation(consts.RobotTokenPreviousHashAnnotation) == "" && rt.needRotation(token, now) {
+			if !dry {
+				err = rt.rotateToken(ctx, rt.ytsaurusClient.GetYtClient(), token, now)
+			}
+			return WaitingStatus(SyncStatusPending, fmt.Sprintf("%s rotation", token.secret.Name())), err
+		}
+	}
+
+	return SimpleStatus(SyncStatusReady), err
+}
+
+func (rt *robotTokens) Status(ctx context.Context) ComponentStatus {
+	status, err := rt.doSync(ctx, true)
+	if err != nil {
+		panic(err)
+	}
+
+	return status
+}
+
+func (rt *robotTokens) Sync(ctx context.Context) error {
+	_, err := rt.doSync(ctx, false)
+	return err
+}
@@ -0,0 +1,122 @@
+package components
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/labeller"
+	mock_yt "github.com/ytsaurus/yt-k8s-operator/pkg/mock"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/resources"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
+	"go.ytsaurus.tech/yt/go/guid"
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Robot tokens test", func() {
+	const secretName = "yt-ui-secret"
+	const oldToken = "old-token"
+
+	var mockYtClient *mock_yt.MockClient
+	var ytsaurus *apiproxy.Ytsaurus
+	var rt *robotTokens
+	var token robotToken
+	var issuedAt time.Time
+
+	BeforeEach(func() {
+		mockYtClient = mock_yt.NewMockClient(ctrl)
+		issuedAt = time.Now().Add(-2 * time.Hour)
+
+		ytsaurusSpec := &v1.Ytsaurus{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ytsaurus",
+				Namespace: "default",
+			},
+			Spec: v1.YtsaurusSpec{
+				RobotTokenRotation: &v1.RobotTokenRotationSpec{
+					Period:      &metav1.Duration{Duration: time.Hour},
+					GracePeriod: &metav1.Duration{Duration: 10 * time.Minute},
+				},
+			},
+		}
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              secretName,
+				Namespace:         "default",
+				CreationTimestamp: metav1.NewTime(issuedAt),
+			},
+			Data: map[string][]byte{
+				consts.TokenSecretKey: []byte(oldToken),
+			},
+		}
+
+		scheme := runtime.NewScheme()
+		Expect(v1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ytsaurusSpec, secret).Build()
+
+		cfgen := ytconfig.NewGenerator(ytsaurusSpec, "cluster_domain")
+		ytsaurus = apiproxy.NewYtsaurus(ytsaurusSpec, k8sClient, record.NewFakeRecorder(10), scheme)
+
+		l := &labeller.Labeller{
+			ObjectMeta:     &ytsaurusSpec.ObjectMeta,
+			APIProxy:       ytsaurus.APIProxy(),
+			ComponentLabel: consts.YTComponentLabelUI,
+			ComponentName:  "UI",
+		}
+		token = newRobotToken(consts.UIUserName, resources.NewStringSecret(secretName, l, ytsaurus.APIProxy()))
+		Expect(token.secret.Fetch(context.Background())).To(Succeed())
+
+		rt = NewRobotTokens(cfgen, ytsaurus, NewFakeYtsaurusClient(mockYtClient), nil).(*robotTokens)
+	})
+
+	It("Outdated token is registered and stored with the previous token hash", func() {
+		now := time.Now()
+		Expect(rt.needRotation(token, now)).Should(BeTrue())
+		Expect(rt.needRevocation(token, now)).Should(BeFalse())
+
+		mockYtClient.EXPECT().
+			CreateObject(gomock.Any(), gomock.Eq(yt.NodeUser), gomock.Any()).
+			Return(yt.NodeID(guid.New()), nil)
+		mockYtClient.EXPECT().
+			CreateNode(gomock.Any(), gomock.Any(), gomock.Eq(yt.NodeMap), gomock.Any()).
+			Return(yt.NodeID(guid.New()), nil)
+		mockYtClient.EXPECT().
+			SetNode(gomock.Any(), gomock.Any(), gomock.Eq(consts.UIUserName), gomock.Nil()).
+			Return(nil)
+
+		Expect(rt.rotateToken(context.Background(), mockYtClient, token, now)).Should(Succeed())
+		Expect(token.secret.Fetch(context.Background())).To(Succeed())
+		Expect(token.secret.GetAnnotation(consts.RobotTokenPreviousHashAnnotation)).Should(Equal(sha256String(oldToken)))
+		Expect(token.getIssueTime().Unix()).Should(Equal(now.Unix()))
+	})
+
+	It("Previous token is revoked after the grace period", func() {
+		token.buildSecret(oldToken, issuedAt, "previous-hash")
+		Expect(token.secret.Sync(context.Background())).To(Succeed())
+		Expect(token.secret.Fetch(context.Background())).To(Succeed())
+
+		Expect(rt.needRevocation(token, issuedAt.Add(5*time.Minute))).Should(BeFalse())
+		Expect(rt.needRevocation(token, time.Now())).Should(BeTrue())
+
+		mockYtClient.EXPECT().
+			RemoveNode(gomock.Any(), gomock.Eq(ypath.Path("//sys/cypress_tokens/previous-hash")), gomock.Any()).
+			Return(nil)
+
+		Expect(rt.revokePreviousToken(context.Background(), mockYtClient, token)).Should(Succeed())
+		Expect(token.secret.Fetch(context.Background())).To(Succeed())
+		Expect(token.secret.GetAnnotation(consts.RobotTokenPreviousHashAnnotation)).Should(BeEmpty())
+		Expect(token.secret.GetAnnotation(consts.RobotTokenIssuedAtAnnotation)).ShouldNot(BeEmpty())
+	})
+})
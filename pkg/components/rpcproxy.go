@@ -2,6 +2,7 @@ package components
 
 import (
 	"context"
+	"fmt"
 	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
 	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
 	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
@@ -20,6 +21,7 @@ type rpcProxy struct {
 	serviceType      *v1.ServiceType
 	balancingService *resources.RPCService
 	tlsSecret        *resources.TLSSecret
+	certificate      *tlsCertificate
 }
 
 func NewRPCProxy(
@@ -48,13 +50,15 @@ func NewRPCProxy(
 			return cfgen.GetRPCProxyConfig(spec)
 		},
 	)
+	server.addPublicPorts(consts.RPCProxyRPCPort, consts.RPCProxyRPCPort)
 
 	var balancingService *resources.RPCService = nil
 	if spec.ServiceType != nil {
 		balancingService = resources.NewRPCService(
 			cfgen.GetRPCProxiesServiceName(spec.Role),
 			&l,
-			ytsaurus.APIProxy())
+			ytsaurus.APIProxy(),
+			&resource.Spec.CommonSpec)
 	}
 
 	var tlsSecret *resources.TLSSecret
@@ -62,7 +66,18 @@ func NewRPCProxy(
 		tlsSecret = resources.NewTLSSecret(
 			secret.Name,
 			consts.RPCSecretVolumeName,
-			consts.RPCSecretMountPoint)
+			consts.RPCSecretMountPoint,
+			ytsaurus.APIProxy())
+	}
+
+	// The certificate is issued into the secret, so it is known only if the secret is set.
+	var certificate *tlsCertificate
+	if spec.Transport.TLSSecret != nil {
+		certificate = newTLSCertificate(
+			spec.Transport.TLSSecret.Name,
+			spec.Transport.TLSCertificate,
+			cfgen.GetRPCProxiesDNSNames(spec.Role),
+			&l)
 	}
 
 	return &rpcProxy{
@@ -76,6 +91,7 @@ func NewRPCProxy(
 		serviceType:      spec.ServiceType,
 		balancingService: balancingService,
 		tlsSecret:        tlsSecret,
+		certificate:      certificate,
 	}
 }
 
@@ -90,6 +106,12 @@ func (rp *rpcProxy) Fetch(ctx context.Context) error {
 	if rp.balancingService != nil {
 		fetchable = append(fetchable, rp.balancingService)
 	}
+	if rp.certificate != nil {
+		fetchable = append(fetchable, rp.certificate)
+	}
+	if rp.tlsSecret != nil {
+		fetchable = append(fetchable, rp.tlsSecret)
+	}
 	return resources.Fetch(ctx, fetchable)
 }
 
@@ -110,12 +132,25 @@ func (rp *rpcProxy) doSync(ctx context.Context, dry bool) (ComponentStatus, erro
 		return WaitingStatus(SyncStatusBlocked, rp.master.GetName()), err
 	}
 
-	if rp.server.needSync() {
+	if rp.certificate != nil && rp.certificate.needSync() {
 		if !dry {
+			err = rp.certificate.Sync(ctx)
+		}
+		return WaitingStatus(SyncStatusPending, rp.certificate.Name()), err
+	}
+
+	if rp.server.needSync() || needTLSSecretRollout(rp.server, rp.tlsSecret) {
+		if !dry {
+			if !rp.server.needSync() {
+				rp.ytsaurus.APIProxy().RecordNormal(
+					"TLSSecretChanged",
+					fmt.Sprintf("Secret %s changed, rolling %s pods", rp.tlsSecret.SecretName, rp.GetName()))
+			}
 			statefulSet := rp.server.buildStatefulSet()
 			if secret := rp.tlsSecret; secret != nil {
 				secret.AddVolume(&statefulSet.Spec.Template.Spec)
 				secret.AddVolumeMount(&statefulSet.Spec.Template.Spec.Containers[0])
+				secret.AddHashAnnotation(&statefulSet.Spec.Template)
 			}
 			err = rp.server.Sync(ctx)
 		}
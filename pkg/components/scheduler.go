@@ -91,6 +91,10 @@ func (s *scheduler) IsUpdatable() bool {
 	return true
 }
 
+func (s *scheduler) getRobotToken() robotToken {
+	return newRobotToken("operation_archivarius", s.secret)
+}
+
 func (s *scheduler) Fetch(ctx context.Context) error {
 	return resources.Fetch(ctx, []resources.Fetchable{
 		s.server,
@@ -0,0 +1,219 @@
+package components
+
+import (
+	"context"
+	"fmt"
+
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+)
+
+type SchedulerPool struct {
+	pool     *apiproxy.SchedulerPool
+	ytClient yt.Client
+}
+
+func NewSchedulerPool(pool *apiproxy.SchedulerPool, ytClient yt.Client) *SchedulerPool {
+	return &SchedulerPool{
+		pool:     pool,
+		ytClient: ytClient,
+	}
+}
+
+func (sp *SchedulerPool) getName() string {
+	resource := sp.pool.GetResource()
+	if resource.Spec.PoolName != "" {
+		return resource.Spec.PoolName
+	}
+	return resource.Name
+}
+
+func getPoolResources(resources *ytv1.PoolResources) map[string]interface{} {
+	result := make(map[string]interface{})
+	if resources.CPU != nil {
+		result["cpu"] = resources.CPU.AsApproximateFloat64()
+	}
+	if resources.Memory != nil {
+		result["memory"] = resources.Memory.Value()
+	}
+	if resources.GPU != nil {
+		result["gpu"] = *resources.GPU
+	}
+	if resources.UserSlots != nil {
+		result["user_slots"] = *resources.UserSlots
+	}
+	return result
+}
+
+func (sp *SchedulerPool) getAttributes() map[string]interface{} {
+	spec := sp.pool.GetResource().Spec
+	attributes := make(map[string]interface{})
+
+	if spec.Mode != "" {
+		attributes["mode"] = spec.Mode
+	}
+	if spec.Weight != nil {
+		attributes["weight"] = spec.Weight.AsApproximateFloat64()
+	}
+	if spec.StrongGuaranteeResources != nil {
+		attributes["strong_guarantee_resources"] = getPoolResources(spec.StrongGuaranteeResources)
+	}
+	if spec.IntegralGuarantees != nil {
+		integralGuarantees := make(map[string]interface{})
+		if spec.IntegralGuarantees.GuaranteeType != "" {
+			integralGuarantees["guarantee_type"] = spec.IntegralGuarantees.GuaranteeType
+		}
+		if spec.IntegralGuarantees.BurstGuaranteeResources != nil {
+			integralGuarantees["burst_guarantee_resources"] = getPoolResources(spec.IntegralGuarantees.BurstGuaranteeResources)
+		}
+		if spec.IntegralGuarantees.ResourceFlow != nil {
+			integralGuarantees["resource_flow"] = getPoolResources(spec.IntegralGuarantees.ResourceFlow)
+		}
+		attributes["integral_guarantees"] = integralGuarantees
+	}
+	if spec.MaxOperationCount != nil {
+		attributes["max_operation_count"] = *spec.MaxOperationCount
+	}
+	if spec.MaxRunningOperationCount != nil {
+		attributes["max_running_operation_count"] = *spec.MaxRunningOperationCount
+	}
+	if spec.SchedulingTagFilter != nil {
+		attributes["scheduling_tag_filter"] = *spec.SchedulingTagFilter
+	}
+
+	return attributes
+}
+
+// findPool returns the path of the pool in the tree, pool names are unique within a tree.
+func findPool(path ypath.Path, pools map[string]interface{}, name string) (ypath.Path, bool) {
+	for poolName, subtree := range pools {
+		poolPath := path.Child(poolName)
+		if poolName == name {
+			return poolPath, true
+		}
+
+		if subpools, ok := subtree.(map[string]interface{}); ok {
+			if result, found := findPool(poolPath, subpools, name); found {
+				return result, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+func (sp *SchedulerPool) doSync(ctx context.Context) error {
+	spec := sp.pool.GetResource().Spec
+	treePath := ypath.Path(fmt.Sprintf("//sys/pool_trees/%s", spec.PoolTree))
+
+	exists, err := sp.ytClient.NodeExists(ctx, treePath, nil)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("pool tree %s does not exist", spec.PoolTree)
+	}
+
+	var pools map[string]interface{}
+	if err = sp.ytClient.GetNode(ctx, treePath, &pools, nil); err != nil {
+		return err
+	}
+
+	parentPath := treePath
+	if spec.ParentPool != "" {
+		var found bool
+		parentPath, found = findPool(treePath, pools, spec.ParentPool)
+		if !found {
+			return fmt.Errorf("parent pool %s does not exist in pool tree %s", spec.ParentPool, spec.PoolTree)
+		}
+	}
+	expectedPath := parentPath.Child(sp.getName())
+
+	path, found := findPool(treePath, pools, sp.getName())
+	if !found {
+		attributes := sp.getAttributes()
+		attributes["name"] = sp.getName()
+		attributes["pool_tree"] = spec.PoolTree
+		if spec.ParentPool != "" {
+			attributes["parent_name"] = spec.ParentPool
+		}
+
+		_, err = sp.ytClient.CreateObject(ctx, yt.NodeSchedulerPool, &yt.CreateObjectOptions{
+			Attributes: attributes,
+		})
+		if err != nil {
+			return err
+		}
+
+		sp.pool.APIProxy().RecordNormal("Created", fmt.Sprintf("Pool %s was created in pool tree %s", sp.getName(), spec.PoolTree))
+		sp.pool.GetResource().Status.Path = expectedPath.String()
+		return nil
+	}
+
+	if path != expectedPath {
+		if _, err = sp.ytClient.MoveNode(ctx, path, expectedPath, nil); err != nil {
+			return err
+		}
+		sp.pool.APIProxy().RecordNormal("Moved", fmt.Sprintf("Pool %s was moved to %s", sp.getName(), expectedPath))
+	}
+
+	for key, value := range sp.getAttributes() {
+		if err = syncNodeAttribute(ctx, sp.ytClient, expectedPath.Attr(key), value); err != nil {
+			return err
+		}
+	}
+
+	sp.pool.GetResource().Status.Path = expectedPath.String()
+	return nil
+}
+
+// Remove removes the pool, it fails while the pool has subpools.
+func (sp *SchedulerPool) Remove(ctx context.Context) error {
+	spec := sp.pool.GetResource().Spec
+	if spec.PoolTree == consts.DefaultPoolTree && sp.getName() == consts.DefaultPool {
+		return nil
+	}
+
+	treePath := ypath.Path(fmt.Sprintf("//sys/pool_trees/%s", spec.PoolTree))
+	exists, err := sp.ytClient.NodeExists(ctx, treePath, nil)
+	if err != nil || !exists {
+		return err
+	}
+
+	var pools map[string]interface{}
+	if err = sp.ytClient.GetNode(ctx, treePath, &pools, nil); err != nil {
+		return err
+	}
+
+	path, found := findPool(treePath, pools, sp.getName())
+	if !found {
+		return nil
+	}
+
+	removed, err := removeCypressObject(ctx, sp.ytClient, path, nil)
+	if removed && err == nil {
+		sp.pool.APIProxy().RecordNormal("Removed", fmt.Sprintf("Pool %s was removed from pool tree %s", sp.getName(), spec.PoolTree))
+	}
+	return err
+}
+
+func (sp *SchedulerPool) Fetch(ctx context.Context) error {
+	return nil
+}
+
+func (sp *SchedulerPool) IsReady() bool {
+	return true
+}
+
+func (sp *SchedulerPool) Sync(ctx context.Context) error {
+	err := sp.doSync(ctx)
+	setSyncedCondition(sp.pool, err)
+	if err == nil {
+		resource := sp.pool.GetResource()
+		resource.Status.ObservedGeneration = resource.Generation
+	}
+	return err
+}
@@ -0,0 +1,177 @@
+package components
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type SchedulerPoolTree struct {
+	poolTree *apiproxy.SchedulerPoolTree
+	ytClient yt.Client
+}
+
+func NewSchedulerPoolTree(poolTree *apiproxy.SchedulerPoolTree, ytClient yt.Client) *SchedulerPoolTree {
+	return &SchedulerPoolTree{
+		poolTree: poolTree,
+		ytClient: ytClient,
+	}
+}
+
+func getPoolTreeName(resource *ytv1.SchedulerPoolTree) string {
+	if resource.Spec.TreeName != "" {
+		return resource.Spec.TreeName
+	}
+	return resource.Name
+}
+
+// getDefaultPoolTree returns the tree which must be set as //sys/pool_trees/@default_tree.
+// SchedulerPoolTree resources own the default tree, the tree created by init jobs is used
+// while none of them is marked as default. If several are marked, the first one by name wins.
+func getDefaultPoolTree(ctx context.Context, k8sClient client.Client, namespace, ytsaurusName string) (string, error) {
+	var poolTrees ytv1.SchedulerPoolTreeList
+	if err := k8sClient.List(ctx, &poolTrees, client.InNamespace(namespace)); err != nil {
+		return "", err
+	}
+
+	sort.Slice(poolTrees.Items, func(i, j int) bool {
+		return poolTrees.Items[i].Name < poolTrees.Items[j].Name
+	})
+	for i := range poolTrees.Items {
+		poolTree := &poolTrees.Items[i]
+		if poolTree.Spec.Ytsaurus.Name == ytsaurusName && poolTree.Spec.Default && poolTree.DeletionTimestamp == nil {
+			return getPoolTreeName(poolTree), nil
+		}
+	}
+
+	return consts.DefaultPoolTree, nil
+}
+
+func (spt *SchedulerPoolTree) getName() string {
+	return getPoolTreeName(spt.poolTree.GetResource())
+}
+
+func (spt *SchedulerPoolTree) getPath() ypath.Path {
+	return ypath.Path(fmt.Sprintf("//sys/pool_trees/%s", spt.getName()))
+}
+
+func (spt *SchedulerPoolTree) getConfig() map[string]interface{} {
+	spec := spt.poolTree.GetResource().Spec
+	config := make(map[string]interface{})
+
+	if spec.NodesFilter != nil {
+		config["nodes_filter"] = *spec.NodesFilter
+	}
+	if spec.DefaultParentPool != nil {
+		config["default_parent_pool"] = *spec.DefaultParentPool
+	}
+	if spec.MaxOperationCountPerPool != nil {
+		config["max_operation_count_per_pool"] = *spec.MaxOperationCountPerPool
+	}
+	if spec.MaxRunningOperationCountPerPool != nil {
+		config["max_running_operation_count_per_pool"] = *spec.MaxRunningOperationCountPerPool
+	}
+
+	return config
+}
+
+func (spt *SchedulerPoolTree) doSync(ctx context.Context) error {
+	exists, err := spt.ytClient.NodeExists(ctx, spt.getPath(), nil)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		_, err = spt.ytClient.CreateObject(ctx, yt.NodeSchedulerPoolTree, &yt.CreateObjectOptions{
+			Attributes: map[string]interface{}{
+				"name":   spt.getName(),
+				"config": spt.getConfig(),
+			},
+		})
+		if err != nil {
+			return err
+		}
+		spt.poolTree.APIProxy().RecordNormal("Created", fmt.Sprintf("Pool tree %s was created", spt.getName()))
+	} else {
+		for key, value := range spt.getConfig() {
+			err = syncNodeAttribute(ctx, spt.ytClient, spt.getPath().Attr("config").Child(key), value)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if !spt.poolTree.GetResource().Spec.Default {
+		return nil
+	}
+
+	resource := spt.poolTree.GetResource()
+	defaultTree, err := getDefaultPoolTree(ctx, spt.poolTree.APIProxy().Client(), resource.Namespace, resource.Spec.Ytsaurus.Name)
+	if err != nil {
+		return err
+	}
+	return syncNodeAttribute(ctx, spt.ytClient, ypath.Path("//sys/pool_trees/@default_tree"), defaultTree)
+}
+
+// Remove removes the pool tree with its pools, the tree created by init jobs is kept.
+// The default tree is switched to another one beforehand.
+func (spt *SchedulerPoolTree) Remove(ctx context.Context) error {
+	if spt.getName() == consts.DefaultPoolTree {
+		return nil
+	}
+
+	defaultTreePath := ypath.Path("//sys/pool_trees/@default_tree")
+	exists, err := spt.ytClient.NodeExists(ctx, defaultTreePath, nil)
+	if err != nil {
+		return err
+	}
+	if exists {
+		var defaultTree string
+		if err = spt.ytClient.GetNode(ctx, defaultTreePath, &defaultTree, nil); err != nil {
+			return err
+		}
+
+		if defaultTree == spt.getName() {
+			resource := spt.poolTree.GetResource()
+			// The deleted resource is not taken into account.
+			defaultTree, err = getDefaultPoolTree(ctx, spt.poolTree.APIProxy().Client(), resource.Namespace, resource.Spec.Ytsaurus.Name)
+			if err != nil {
+				return err
+			}
+			if err = spt.ytClient.SetNode(ctx, defaultTreePath, defaultTree, nil); err != nil {
+				return err
+			}
+		}
+	}
+
+	removed, err := removeCypressObject(ctx, spt.ytClient, spt.getPath(), &yt.RemoveNodeOptions{Recursive: true})
+	if removed && err == nil {
+		spt.poolTree.APIProxy().RecordNormal("Removed", fmt.Sprintf("Pool tree %s was removed", spt.getName()))
+	}
+	return err
+}
+
+func (spt *SchedulerPoolTree) Fetch(ctx context.Context) error {
+	return nil
+}
+
+func (spt *SchedulerPoolTree) IsReady() bool {
+	return true
+}
+
+func (spt *SchedulerPoolTree) Sync(ctx context.Context) error {
+	err := spt.doSync(ctx)
+	setSyncedCondition(spt.poolTree, err)
+	if err == nil {
+		resource := spt.poolTree.GetResource()
+		resource.Status.ObservedGeneration = resource.Generation
+	}
+	return err
+}
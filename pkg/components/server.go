@@ -2,6 +2,7 @@ package components
 
 import (
 	"context"
+	"fmt"
 	ptr "k8s.io/utils/pointer"
 	"log"
 	"path"
@@ -14,6 +15,7 @@ import (
 	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // server manages common resources of YTsaurus cluster server components.
@@ -25,12 +27,13 @@ type server interface {
 	needSync() bool
 	buildStatefulSet() *appsv1.StatefulSet
 	rebuildStatefulSet() *appsv1.StatefulSet
+	getPodTemplate() *corev1.PodTemplateSpec
 }
 
 type serverImpl struct {
-	image    string
-	labeller *labeller.Labeller
-	ytsaurus *apiproxy.Ytsaurus
+	image      string
+	labeller   *labeller.Labeller
+	commonSpec *ytv1.CommonSpec
 
 	binaryPath string
 
@@ -41,6 +44,21 @@ type serverImpl struct {
 	monitoringService *resources.MonitoringService
 	configHelper      *ConfigHelper
 
+	// Internal transport resources are set only if bus encryption is configured.
+	busCertificate  *resources.Certificate
+	busSecret       *resources.TLSSecret
+	busCASecret     *resources.TLSSecret
+	mountBusSecrets bool
+	// busEncryptionMode is the mode of the configs, it is empty if the internal transport is not configured.
+	busEncryptionMode ytv1.BusEncryptionMode
+
+	// hostAddresses pin the pods to the nodes when host network is used, see setHostAddresses.
+	hostAddresses    []string
+	hostAddressLabel string
+
+	// networkPolicy is not set for remote nodes, which are not a part of the cluster.
+	networkPolicy *networkPolicy
+
 	builtStatefulSet *appsv1.StatefulSet
 }
 
@@ -49,33 +67,66 @@ func newServer(
 	ytsaurus *apiproxy.Ytsaurus,
 	instanceSpec *ytv1.InstanceSpec,
 	binaryPath, configFileName, statefulSetName, serviceName string,
-	generator ytconfig.YsonGeneratorFunc) server {
-	image := ytsaurus.GetResource().Spec.CoreImage
+	generator ytconfig.YsonGeneratorFunc) *serverImpl {
+	s := newServerConfigured(
+		l,
+		&ytsaurus.GetResource().Spec.CommonSpec,
+		instanceSpec,
+		binaryPath, configFileName, statefulSetName, serviceName,
+		generator,
+	)
+
+	if transport := ytsaurus.GetResource().Spec.InternalTransport; transport != nil {
+		s.setInternalTransport(
+			transport,
+			ytsaurus.GetInternalTransportEncryptionMode(),
+			statefulSetName,
+			serviceName)
+	}
+
+	// The policy is created even if it is disabled, so that it is removed.
+	s.networkPolicy = newNetworkPolicy(ytsaurus.GetResource().Spec.NetworkPolicy, l)
+
+	return s
+}
+
+// newServerConfigured creates a server which is not necessarily a part of Ytsaurus,
+// e.g. remote nodes. All the objects are managed through the API proxy of the labeller.
+func newServerConfigured(
+	l *labeller.Labeller,
+	commonSpec *ytv1.CommonSpec,
+	instanceSpec *ytv1.InstanceSpec,
+	binaryPath, configFileName, statefulSetName, serviceName string,
+	generator ytconfig.YsonGeneratorFunc) *serverImpl {
+	image := commonSpec.CoreImage
 	if instanceSpec.Image != nil {
 		image = *instanceSpec.Image
 	}
 	return &serverImpl{
 		labeller:     l,
 		image:        image,
-		ytsaurus:     ytsaurus,
+		commonSpec:   commonSpec,
 		instanceSpec: instanceSpec,
 		binaryPath:   binaryPath,
 		statefulSet: resources.NewStatefulSet(
 			statefulSetName,
 			l,
-			ytsaurus),
+			l.APIProxy,
+			commonSpec),
 		headlessService: resources.NewHeadlessService(
 			serviceName,
 			l,
-			ytsaurus.APIProxy()),
+			l.APIProxy,
+			commonSpec),
 		monitoringService: resources.NewMonitoringService(
 			l,
-			ytsaurus.APIProxy()),
+			l.APIProxy,
+			commonSpec),
 		configHelper: NewConfigHelper(
 			l,
-			ytsaurus.APIProxy(),
+			l.APIProxy,
 			l.GetMainConfigMapName(),
-			ytsaurus.GetResource().Spec.ConfigOverrides,
+			commonSpec.ConfigOverrides,
 			map[string]ytconfig.GeneratorDescriptor{
 				configFileName: {
 					F:   generator,
@@ -85,13 +136,83 @@ func newServer(
 	}
 }
 
+// setInternalTransport requests the bus certificate of the server. The secrets are mounted
+// only when the encryption is enabled, since the certificate may not be issued yet.
+func (s *serverImpl) setInternalTransport(
+	transport *ytv1.InternalTransportSpec,
+	mode ytv1.BusEncryptionMode,
+	statefulSetName, serviceName string) {
+	secretName := statefulSetName + consts.BusSecretNameSuffix
+	namespace := s.labeller.ObjectMeta.Namespace
+
+	// Peers are verified against the CA only, so the cluster domain is not needed here.
+	s.busCertificate = resources.NewCertificate(
+		secretName,
+		&ytv1.CertificateSpec{IssuerRef: transport.IssuerRef},
+		[]string{
+			fmt.Sprintf("%s.%s.svc", serviceName, namespace),
+			fmt.Sprintf("*.%s.%s.svc", serviceName, namespace),
+		},
+		s.labeller,
+		s.labeller.APIProxy)
+	s.busSecret = resources.NewTLSSecret(
+		secretName,
+		consts.BusSecretVolumeName,
+		consts.BusSecretMountPoint,
+		s.labeller.APIProxy)
+	if transport.CASecret != nil {
+		s.busCASecret = resources.NewTLSSecret(
+			transport.CASecret.Name,
+			consts.BusCASecretVolumeName,
+			consts.BusCASecretMountPoint,
+			s.labeller.APIProxy)
+	}
+	s.busEncryptionMode = mode
+	s.mountBusSecrets = mode != ytv1.BusEncryptionModeDisabled
+}
+
+// setHostAddresses makes the server run exactly on the given nodes, one pod per node.
+// It is needed for the components whose addresses are known in advance, e.g. masters.
+func (s *serverImpl) setHostAddresses(hostAddresses []string, hostAddressLabel string) {
+	if hostAddressLabel == "" {
+		hostAddressLabel = corev1.LabelHostname
+	}
+	s.hostAddresses = hostAddresses
+	s.hostAddressLabel = hostAddressLabel
+}
+
+// addPublicPorts makes the ports reachable from outside of the cluster if network policies are enabled.
+func (s *serverImpl) addPublicPorts(port, endPort int32) {
+	if s.networkPolicy != nil {
+		s.networkPolicy.addPublicPorts(port, endPort)
+	}
+}
+
 func (s *serverImpl) Fetch(ctx context.Context) error {
-	return resources.Fetch(ctx, []resources.Fetchable{
+	fetchable := []resources.Fetchable{
 		s.statefulSet,
 		s.configHelper,
 		s.headlessService,
 		s.monitoringService,
-	})
+	}
+	if s.busCertificate != nil {
+		fetchable = append(fetchable, s.busCertificate, s.busSecret)
+	}
+	if s.busCASecret != nil {
+		fetchable = append(fetchable, s.busCASecret)
+	}
+	if s.networkPolicy != nil {
+		fetchable = append(fetchable, s.networkPolicy)
+	}
+	return resources.Fetch(ctx, fetchable)
+}
+
+// isBusCertificateReady checks that the certificate is requested and issued.
+func (s *serverImpl) isBusCertificateReady() bool {
+	if s.busCertificate == nil {
+		return true
+	}
+	return !s.busCertificate.NeedSync() && resources.Exists(s.busSecret)
 }
 
 func (s *serverImpl) exists() bool {
@@ -103,21 +224,68 @@ func (s *serverImpl) exists() bool {
 func (s *serverImpl) needSync() bool {
 	return s.configHelper.NeedSync() ||
 		!s.exists() ||
-		s.statefulSet.NeedSync(s.instanceSpec.InstanceCount)
+		s.statefulSet.NeedSync(s.instanceSpec.InstanceCount) ||
+		!s.isBusCertificateReady() ||
+		s.needBusSecretRollout() ||
+		s.needBusEncryptionModeRollout() ||
+		(s.networkPolicy != nil && s.networkPolicy.needSync())
+}
+
+// needBusEncryptionModeRollout checks if the pods were created with configs of another bus encryption mode.
+// Pods created before the internal transport was configured have no annotation and run without encryption.
+func (s *serverImpl) needBusEncryptionModeRollout() bool {
+	if s.busEncryptionMode == "" {
+		return false
+	}
+	mode, ok := s.getPodTemplate().Annotations[consts.BusEncryptionModeAnnotation]
+	if !ok {
+		mode = string(ytv1.BusEncryptionModeDisabled)
+	}
+	return mode != string(s.busEncryptionMode)
+}
+
+// needBusSecretRollout checks if the pods were created with other contents of the bus secrets,
+// e.g. the certificate was renewed.
+func (s *serverImpl) needBusSecretRollout() bool {
+	if !s.mountBusSecrets {
+		return false
+	}
+	template := s.getPodTemplate()
+	return s.busSecret.NeedHashUpdate(template) ||
+		(s.busCASecret != nil && s.busCASecret.NeedHashUpdate(template))
 }
 
 func (s *serverImpl) Sync(ctx context.Context) error {
+	if s.needBusSecretRollout() {
+		s.labeller.APIProxy.RecordNormal(
+			"TLSSecretChanged",
+			fmt.Sprintf("Bus secrets changed, rolling %s pods", s.labeller.ComponentName))
+	}
+	if s.needBusEncryptionModeRollout() {
+		s.labeller.APIProxy.RecordNormal(
+			"InternalTransport",
+			fmt.Sprintf("Rolling %s pods to bus encryption mode %s", s.labeller.ComponentName, s.busEncryptionMode))
+	}
+
 	_ = s.configHelper.Build()
 	_ = s.headlessService.Build()
 	_ = s.monitoringService.Build()
 	_ = s.buildStatefulSet()
 
-	return resources.Sync(ctx, []resources.Syncable{
+	var syncable []resources.Syncable
+	if s.busCertificate != nil {
+		syncable = append(syncable, s.busCertificate)
+	}
+	syncable = append(syncable,
 		s.statefulSet,
 		s.configHelper,
 		s.headlessService,
-		s.monitoringService,
-	})
+		s.monitoringService)
+	if s.networkPolicy != nil {
+		syncable = append(syncable, s.networkPolicy)
+	}
+
+	return resources.Sync(ctx, syncable)
 }
 
 func (s *serverImpl) arePodsRemoved() bool {
@@ -141,6 +309,12 @@ func (s *serverImpl) needUpdate() bool {
 		return true
 	}
 
+	// Components in adjacent encryption modes can talk to each other, so a step of the mode
+	// is rolled out by the rolling update of the stateful set instead of the full update.
+	if s.needBusEncryptionModeRollout() {
+		return false
+	}
+
 	needReload, err := s.configHelper.NeedReload()
 	if err != nil {
 		return false
@@ -176,10 +350,8 @@ func (s *serverImpl) rebuildStatefulSet() *appsv1.StatefulSet {
 		log.Panicf("expected exactly one config filename, found %v", len(fileNames))
 	}
 
-	setHostnameAsFQDN := true
 	statefulSet.Spec.Template.Spec = corev1.PodSpec{
-		ImagePullSecrets:  s.ytsaurus.GetResource().Spec.ImagePullSecrets,
-		SetHostnameAsFQDN: &setHostnameAsFQDN,
+		ImagePullSecrets: s.commonSpec.ImagePullSecrets,
 		Containers: []corev1.Container{
 			{
 				Image:        s.image,
@@ -202,18 +374,96 @@ func (s *serverImpl) rebuildStatefulSet() *appsv1.StatefulSet {
 		NodeSelector: s.instanceSpec.NodeSelector,
 		Tolerations:  s.instanceSpec.Tolerations,
 	}
-	// TODO(zlobober): support host network for masters.
-	if s.ytsaurus.GetResource().Spec.HostNetwork && s.labeller.ComponentName != "Master" {
+	if s.busEncryptionMode != "" {
+		metav1.SetMetaDataAnnotation(&statefulSet.Spec.Template.ObjectMeta, consts.BusEncryptionModeAnnotation, string(s.busEncryptionMode))
+	}
+	if s.mountBusSecrets {
+		s.busSecret.AddVolume(&statefulSet.Spec.Template.Spec)
+		s.busSecret.AddVolumeMount(&statefulSet.Spec.Template.Spec.Containers[0])
+		s.busSecret.AddHashAnnotation(&statefulSet.Spec.Template)
+		if s.busCASecret != nil {
+			s.busCASecret.AddVolume(&statefulSet.Spec.Template.Spec)
+			s.busCASecret.AddVolumeMount(&statefulSet.Spec.Template.Spec.Containers[0])
+			s.busCASecret.AddHashAnnotation(&statefulSet.Spec.Template)
+		}
+	}
+	// Masters are run in host network only if their host addresses are known in advance.
+	if s.commonSpec.HostNetwork && (s.labeller.ComponentName != "Master" || s.useHostAddresses()) {
 		statefulSet.Spec.Template.Spec.HostNetwork = true
 		statefulSet.Spec.Template.Spec.DNSPolicy = corev1.DNSClusterFirstWithHostNet
 	}
+	if s.useHostAddresses() {
+		// Pods are known by the node hostnames in this case, so SetHostnameAsFQDN is not set.
+		statefulSet.Spec.Template.Spec.Affinity = s.getHostAddressesAffinity()
+	} else {
+		setHostnameAsFQDN := true
+		statefulSet.Spec.Template.Spec.SetHostnameAsFQDN = &setHostnameAsFQDN
+	}
 
 	s.builtStatefulSet = statefulSet
 	return statefulSet
 }
 
+func (s *serverImpl) useHostAddresses() bool {
+	return s.commonSpec.HostNetwork && len(s.hostAddresses) != 0
+}
+
+// getHostAddressesAffinity adds the host addresses restrictions to the affinity from the instance spec.
+func (s *serverImpl) getHostAddressesAffinity() *corev1.Affinity {
+	affinity := &corev1.Affinity{}
+	if s.instanceSpec.Affinity != nil {
+		affinity = s.instanceSpec.Affinity.DeepCopy()
+	}
+
+	hostRequirement := corev1.NodeSelectorRequirement{
+		Key:      s.hostAddressLabel,
+		Operator: corev1.NodeSelectorOpIn,
+		Values:   s.hostAddresses,
+	}
+	if affinity.NodeAffinity == nil {
+		affinity.NodeAffinity = &corev1.NodeAffinity{}
+	}
+	nodeSelector := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if nodeSelector == nil || len(nodeSelector.NodeSelectorTerms) == 0 {
+		nodeSelector = &corev1.NodeSelector{
+			NodeSelectorTerms: []corev1.NodeSelectorTerm{{}},
+		}
+	}
+	// Node selector terms are ORed, so the requirement must be added to each of them.
+	for i := range nodeSelector.NodeSelectorTerms {
+		term := &nodeSelector.NodeSelectorTerms[i]
+		term.MatchExpressions = append(term.MatchExpressions, hostRequirement)
+	}
+	affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = nodeSelector
+
+	// All pods use the same ports of the node, so they can't share it.
+	if affinity.PodAntiAffinity == nil {
+		affinity.PodAntiAffinity = &corev1.PodAntiAffinity{}
+	}
+	affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(
+		affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution,
+		corev1.PodAffinityTerm{
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: s.labeller.GetSelectorLabelMap(),
+			},
+			TopologyKey: s.hostAddressLabel,
+		})
+
+	return affinity
+}
+
+// getPodTemplate returns the pod template of the existing stateful set.
+func (s *serverImpl) getPodTemplate() *corev1.PodTemplateSpec {
+	return &s.statefulSet.OldObject().(*appsv1.StatefulSet).Spec.Template
+}
+
 func (s *serverImpl) removePods(ctx context.Context) error {
 	ss := s.rebuildStatefulSet()
 	ss.Spec.Replicas = ptr.Int32(0)
 	return s.Sync(ctx)
 }
+
+// needTLSSecretRollout checks if the server pods were created with other contents of the secret.
+func needTLSSecretRollout(s server, secret *resources.TLSSecret) bool {
+	return secret != nil && secret.NeedHashUpdate(s.getPodTemplate())
+}
@@ -0,0 +1,112 @@
+package components
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Server test", func() {
+	var ytsaurusSpec *v1.Ytsaurus
+	var ytsaurus *apiproxy.Ytsaurus
+	var cfgen *ytconfig.Generator
+
+	userAffinity := &corev1.Affinity{
+		NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{
+					{
+						MatchExpressions: []corev1.NodeSelectorRequirement{
+							{
+								Key:      "dedicated",
+								Operator: corev1.NodeSelectorOpIn,
+								Values:   []string{"yt"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	BeforeEach(func() {
+		ytsaurusSpec = &v1.Ytsaurus{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ytsaurus",
+				Namespace: "default",
+			},
+			Spec: v1.YtsaurusSpec{
+				CommonSpec: v1.CommonSpec{
+					CoreImage:   "ytsaurus/ytsaurus:latest",
+					HostNetwork: true,
+				},
+				Discovery: v1.DiscoverySpec{
+					InstanceSpec: v1.InstanceSpec{
+						InstanceCount: 1,
+					},
+				},
+				PrimaryMasters: v1.MastersSpec{
+					InstanceSpec: v1.InstanceSpec{
+						InstanceCount: 2,
+						Affinity:      userAffinity,
+					},
+				},
+			},
+		}
+
+		scheme := runtime.NewScheme()
+		Expect(v1.AddToScheme(scheme)).To(Succeed())
+		k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ytsaurusSpec).Build()
+		ytsaurus = apiproxy.NewYtsaurus(ytsaurusSpec, k8sClient, record.NewFakeRecorder(10), scheme)
+		cfgen = ytconfig.NewGenerator(ytsaurusSpec, "cluster_domain")
+	})
+
+	It("Masters without host addresses are not run in host network", func() {
+		server := NewMaster(cfgen, ytsaurus).(*master).server.(*serverImpl)
+		podSpec := server.rebuildStatefulSet().Spec.Template.Spec
+
+		Expect(podSpec.HostNetwork).Should(BeFalse())
+		Expect(podSpec.SetHostnameAsFQDN).ShouldNot(BeNil())
+		Expect(podSpec.Affinity).Should(Equal(userAffinity))
+	})
+
+	It("Other components keep host network settings", func() {
+		server := NewDiscovery(cfgen, ytsaurus).(*discovery).server.(*serverImpl)
+		podSpec := server.rebuildStatefulSet().Spec.Template.Spec
+
+		Expect(podSpec.HostNetwork).Should(BeTrue())
+		Expect(podSpec.DNSPolicy).Should(Equal(corev1.DNSClusterFirstWithHostNet))
+		Expect(podSpec.SetHostnameAsFQDN).ShouldNot(BeNil())
+	})
+
+	It("Masters with host addresses are pinned to the nodes", func() {
+		ytsaurusSpec.Spec.PrimaryMasters.HostAddresses = []string{"host-1", "host-2"}
+		server := NewMaster(cfgen, ytsaurus).(*master).server.(*serverImpl)
+		podSpec := server.rebuildStatefulSet().Spec.Template.Spec
+
+		Expect(podSpec.HostNetwork).Should(BeTrue())
+		Expect(podSpec.SetHostnameAsFQDN).Should(BeNil())
+
+		terms := podSpec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+		Expect(terms).Should(HaveLen(1))
+		Expect(terms[0].MatchExpressions).Should(ConsistOf(
+			userAffinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions[0],
+			corev1.NodeSelectorRequirement{
+				Key:      corev1.LabelHostname,
+				Operator: corev1.NodeSelectorOpIn,
+				Values:   []string{"host-1", "host-2"},
+			},
+		))
+		Expect(podSpec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution).Should(HaveLen(1))
+
+		// The affinity from the spec is not modified.
+		Expect(userAffinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions).Should(HaveLen(1))
+	})
+})
@@ -38,7 +38,13 @@ func NewSpyt(
 		ComponentName:  fmt.Sprintf("SPYT-%s", spyt.GetResource().Name),
 	}
 
-	return &Spyt{
+	// Release job keeps the image it was started with, a newer image is released after it.
+	image := spyt.GetResource().Status.ReleasingImage
+	if image == "" {
+		image = spyt.GetResource().Spec.Image
+	}
+
+	s := &Spyt{
 		labeller: &l,
 		spyt:     spyt,
 		cfgen:    cfgen,
@@ -59,13 +65,17 @@ func NewSpyt(
 			ytsaurus.Spec.ImagePullSecrets,
 			"spyt-environment",
 			consts.ClientConfigFileName,
-			spyt.GetResource().Spec.Image,
+			image,
 			cfgen.GetNativeClientConfig),
 		secret: resources.NewStringSecret(
 			l.GetSecretName(),
 			&l,
 			spyt.APIProxy()),
 	}
+	for _, job := range []*InitJob{s.initUser, s.initEnvironment} {
+		job.SetClusterName(ytsaurus.Name)
+	}
+	return s
 }
 
 func (s *Spyt) createInitUserScript() string {
@@ -81,12 +91,54 @@ func (s *Spyt) createInitUserScript() string {
 
 func (s *Spyt) createInitScript() string {
 	script := []string{
+		initJobPrologue,
 		"/entrypoint.sh",
+		// Published versions are kept, the new one becomes the default only after it is published.
+		"version=$(python3 -c 'from spyt.version import __version__; print(__version__)')",
+		`yt set //home/spark/conf/global/latest_spyt_version "\"$version\""`,
 	}
 
 	return strings.Join(script, "\n")
 }
 
+// needRelease reports that the image in the spec is not released and no release is running.
+func (s *Spyt) needRelease() bool {
+	status := s.spyt.GetResource().Status
+	return status.ReleasingImage == "" && status.ObservedImage != s.spyt.GetResource().Spec.Image
+}
+
+func (s *Spyt) prepareRelease(ctx context.Context) error {
+	resource := s.spyt.GetResource()
+	if resource.Status.ObservedImage == "" {
+		s.spyt.APIProxy().RecordNormal("Releasing", fmt.Sprintf("Releasing %s", resource.Spec.Image))
+	} else {
+		s.spyt.APIProxy().RecordNormal(
+			"Releasing",
+			fmt.Sprintf("Releasing %s, previous release %s is kept", resource.Spec.Image, resource.Status.ObservedImage))
+	}
+
+	if err := s.initEnvironment.prepareRestart(ctx, false); err != nil {
+		return err
+	}
+
+	resource.Status.ReleasingImage = resource.Spec.Image
+	return nil
+}
+
+// finishRelease records the image of the completed release job.
+// If the image in the spec was changed during the release, it is released on the next sync.
+func (s *Spyt) finishRelease() {
+	resource := s.spyt.GetResource()
+	if resource.Status.ReleasingImage == "" {
+		return
+	}
+
+	resource.Status.ObservedImage = resource.Status.ReleasingImage
+	resource.Status.ObservedGeneration = resource.Generation
+	resource.Status.ReleasedImages = appendReleasedImage(resource.Status.ReleasedImages, resource.Status.ReleasingImage)
+	resource.Status.ReleasingImage = ""
+}
+
 func (s *Spyt) doSync(ctx context.Context, dry bool) (ComponentStatus, error) {
 	var err error
 
@@ -94,7 +146,8 @@ func (s *Spyt) doSync(ctx context.Context, dry bool) (ComponentStatus, error) {
 		return WaitingStatus(SyncStatusBlocked, s.ytsaurus.GetName()), err
 	}
 
-	if s.spyt.GetResource().Status.ReleaseStatus == ytv1.SpytReleaseStatusFinished {
+	resource := s.spyt.GetResource()
+	if resource.Status.ReleaseStatus == ytv1.SpytReleaseStatusFinished && !s.needRelease() {
 		return SimpleStatus(SyncStatusReady), err
 	}
 
@@ -120,6 +173,15 @@ func (s *Spyt) doSync(ctx context.Context, dry bool) (ComponentStatus, error) {
 		return status, err
 	}
 
+	if s.needRelease() {
+		// Release job is re-run with the new image, older releases are kept in Cypress.
+		if !dry {
+			err = s.prepareRelease(ctx)
+		}
+		resource.Status.ReleaseStatus = ytv1.SpytReleaseStatusUploadingIntoCypress
+		return WaitingStatus(SyncStatusPending, fmt.Sprintf("release of %s", resource.Spec.Image)), err
+	}
+
 	if !dry {
 		s.initEnvironment.SetInitScript(s.createInitScript())
 		job := s.initEnvironment.Build()
@@ -147,7 +209,8 @@ func (s *Spyt) doSync(ctx context.Context, dry bool) (ComponentStatus, error) {
 		return status, err
 	}
 
-	s.spyt.GetResource().Status.ReleaseStatus = ytv1.SpytReleaseStatusFinished
+	resource.Status.ReleaseStatus = ytv1.SpytReleaseStatusFinished
+	s.finishRelease()
 
 	return SimpleStatus(SyncStatusReady), nil
 }
@@ -0,0 +1,261 @@
+package components
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/labeller"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/resources"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
+	"go.ytsaurus.tech/yt/go/guid"
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type SpytCluster struct {
+	labeller *labeller.Labeller
+	cluster  *apiproxy.SpytCluster
+	cfgen    *ytconfig.Generator
+	ytClient yt.Client
+
+	launchJob *InitJob
+}
+
+func NewSpytCluster(
+	cfgen *ytconfig.Generator,
+	cluster *apiproxy.SpytCluster,
+	ytsaurus *ytv1.Ytsaurus,
+	ytClient yt.Client) *SpytCluster {
+
+	resource := cluster.GetResource()
+	l := labeller.Labeller{
+		ObjectMeta:     &resource.ObjectMeta,
+		APIProxy:       cluster.APIProxy(),
+		ComponentLabel: fmt.Sprintf("ytsaurus-spyt-cluster-%s", resource.Name),
+		ComponentName:  fmt.Sprintf("SPYTCluster-%s", resource.Name),
+	}
+
+	var imagePullSecrets []corev1.LocalObjectReference
+	imagePullSecrets = append(imagePullSecrets, ytsaurus.Spec.ImagePullSecrets...)
+	imagePullSecrets = append(imagePullSecrets, resource.Spec.ImagePullSecrets...)
+
+	sc := &SpytCluster{
+		labeller: &l,
+		cluster:  cluster,
+		cfgen:    cfgen,
+		ytClient: ytClient,
+		launchJob: NewInitJob(
+			&l,
+			cluster.APIProxy(),
+			cluster,
+			imagePullSecrets,
+			"launch",
+			consts.ClientConfigFileName,
+			resource.Spec.Image,
+			cfgen.GetNativeClientConfig),
+	}
+	sc.launchJob.SetClusterName(ytsaurus.Name)
+	return sc
+}
+
+func (sc *SpytCluster) getDiscoveryPath() ypath.Path {
+	resource := sc.cluster.GetResource()
+	if resource.Spec.DiscoveryPath != "" {
+		return ypath.Path(resource.Spec.DiscoveryPath)
+	}
+	return ypath.Path(fmt.Sprintf("//home/spark/discovery/%s", resource.Name))
+}
+
+func (sc *SpytCluster) createLaunchScript() string {
+	spec := sc.cluster.GetResource().Spec
+
+	command := []string{
+		"spark-launch-yt",
+		fmt.Sprintf("--discovery-path %s", sc.getDiscoveryPath()),
+		fmt.Sprintf("--worker-num %d", spec.WorkerCount),
+		fmt.Sprintf("--worker-cores %d", spec.WorkerCores),
+		fmt.Sprintf("--worker-memory %dM", spec.WorkerMemory.Value()/(1024*1024)),
+		// A running operation is replaced on restarts after spec changes.
+		"--abort-existing",
+	}
+	if spec.Pool != "" {
+		command = append(command, fmt.Sprintf("--pool %s", spec.Pool))
+	}
+	if spec.EnableHistoryServer {
+		command = append(command, "--enable-history-server")
+	}
+
+	script := []string{
+		initJobPrologue,
+		fmt.Sprintf("export YT_PROXY=%v", sc.cfgen.GetHTTPProxiesAddress(consts.DefaultHTTPProxyRole)),
+		strings.Join(command, " "),
+	}
+
+	return strings.Join(script, "\n")
+}
+
+func (sc *SpytCluster) prepareLaunchJob() {
+	sc.launchJob.SetInitScript(sc.createLaunchScript())
+
+	job := sc.launchJob.Build()
+	container := &job.Spec.Template.Spec.Containers[0]
+	container.EnvFrom = []corev1.EnvFromSource{
+		{
+			SecretRef: &corev1.SecretEnvSource{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: GetYtsaurusClientSecretName(),
+				},
+			},
+		},
+	}
+}
+
+// getDiscoveryValue returns the value published by the cluster, values are stored as names of child nodes.
+func (sc *SpytCluster) getDiscoveryValue(ctx context.Context, key string) (string, error) {
+	path := sc.getDiscoveryPath().Child("discovery").Child(key)
+	exists, err := sc.ytClient.NodeExists(ctx, path, nil)
+	if err != nil || !exists {
+		return "", err
+	}
+
+	var values []string
+	if err = sc.ytClient.ListNode(ctx, path, &values, nil); err != nil {
+		return "", err
+	}
+	if len(values) == 0 {
+		return "", nil
+	}
+	return values[0], nil
+}
+
+func (sc *SpytCluster) updateStatus(ctx context.Context) error {
+	status := &sc.cluster.GetResource().Status
+
+	operationID, err := sc.getDiscoveryValue(ctx, "operation")
+	if err != nil {
+		return err
+	}
+	status.OperationID = operationID
+	status.OperationState = ""
+
+	if operationID != "" {
+		id, err := guid.ParseString(operationID)
+		if err != nil {
+			return err
+		}
+
+		operation, err := sc.ytClient.GetOperation(ctx, yt.OperationID(id), nil)
+		if err != nil {
+			return err
+		}
+		status.OperationState = string(operation.State)
+	}
+
+	if status.MasterAddress, err = sc.getDiscoveryValue(ctx, "spark_address"); err != nil {
+		return err
+	}
+
+	webUI, err := sc.getDiscoveryValue(ctx, "webui")
+	if err != nil {
+		return err
+	}
+	status.MasterWebUI = ""
+	if webUI != "" {
+		status.MasterWebUI = fmt.Sprintf("http://%s", webUI)
+	}
+
+	status.HistoryServerAddress, err = sc.getDiscoveryValue(ctx, "shs")
+	return err
+}
+
+func (sc *SpytCluster) isRunning() bool {
+	status := sc.cluster.GetResource().Status
+	return status.OperationState != "" && !yt.OperationState(status.OperationState).IsFinished()
+}
+
+func (sc *SpytCluster) restart(ctx context.Context, reason string) error {
+	resource := sc.cluster.GetResource()
+	sc.cluster.APIProxy().RecordWarning("Restarting", fmt.Sprintf("Restarting SPYT cluster: %s", reason))
+	log.FromContext(ctx).Info("Restarting SPYT cluster", "reason", reason)
+
+	resource.Status.Restarts += 1
+	return sc.launchJob.prepareRestart(ctx, false)
+}
+
+func (sc *SpytCluster) doSync(ctx context.Context) error {
+	resource := sc.cluster.GetResource()
+
+	if !sc.launchJob.isRestartCompleted() {
+		sc.prepareLaunchJob()
+		if _, err := sc.launchJob.Sync(ctx, false); err != nil {
+			return err
+		}
+
+		// The launched operation is running with the current spec.
+		if sc.launchJob.isRestartCompleted() {
+			resource.Status.ObservedGeneration = resource.Generation
+		}
+		return nil
+	}
+
+	if err := sc.updateStatus(ctx); err != nil {
+		return err
+	}
+
+	if !sc.isRunning() {
+		if resource.Status.OperationState == "" {
+			return sc.restart(ctx, "operation is not found")
+		}
+		return sc.restart(ctx, fmt.Sprintf("operation %s is %s", resource.Status.OperationID, resource.Status.OperationState))
+	}
+
+	if resource.Status.ObservedGeneration != resource.Generation {
+		return sc.restart(ctx, "spec was changed")
+	}
+
+	return nil
+}
+
+// Remove aborts the operation of the cluster, the launch job is removed with the resource.
+func (sc *SpytCluster) Remove(ctx context.Context) error {
+	if err := sc.updateStatus(ctx); err != nil {
+		return err
+	}
+	if !sc.isRunning() {
+		return nil
+	}
+
+	status := sc.cluster.GetResource().Status
+	id, err := guid.ParseString(status.OperationID)
+	if err != nil {
+		return err
+	}
+	if err = sc.ytClient.AbortOperation(ctx, yt.OperationID(id), nil); err != nil {
+		return err
+	}
+
+	sc.cluster.APIProxy().RecordNormal("Aborted", fmt.Sprintf("Operation %s of SPYT cluster was aborted", status.OperationID))
+	return nil
+}
+
+func (sc *SpytCluster) IsReady() bool {
+	return sc.launchJob.isRestartCompleted() && sc.isRunning()
+}
+
+func (sc *SpytCluster) Fetch(ctx context.Context) error {
+	return resources.Fetch(ctx, []resources.Fetchable{
+		sc.launchJob,
+	})
+}
+
+func (sc *SpytCluster) Sync(ctx context.Context) error {
+	err := sc.doSync(ctx)
+	setSyncedCondition(sc.cluster, err)
+	return err
+}
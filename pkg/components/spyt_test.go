@@ -0,0 +1,183 @@
+package components
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Spyt test", func() {
+	var ytsaurusSpec *v1.Ytsaurus
+	var resource *v1.Spyt
+	var k8sClient client.Client
+	var scheme *runtime.Scheme
+
+	newSpyt := func() *Spyt {
+		cfgen := ytconfig.NewGenerator(ytsaurusSpec, "cluster_domain")
+		spyt := NewSpyt(cfgen, apiproxy.NewSpyt(resource, k8sClient, record.NewFakeRecorder(10), scheme), ytsaurusSpec)
+		Expect(spyt.Fetch(context.Background())).To(Succeed())
+		return spyt
+	}
+
+	// syncSpyt runs the sync steps until the release is blocked or finished.
+	syncSpyt := func() ComponentStatus {
+		for i := 0; i < 10; i++ {
+			spyt := newSpyt()
+			status := spyt.Status(context.Background())
+			if status.SyncStatus != SyncStatusPending {
+				return status
+			}
+			Expect(spyt.Sync(context.Background())).To(Succeed())
+		}
+		Fail("Spyt is not synced")
+		return ComponentStatus{}
+	}
+
+	getJob := func(job *InitJob) *batchv1.Job {
+		var result batchv1.Job
+		key := client.ObjectKey{Name: job.initJob.Name(), Namespace: "default"}
+		if err := k8sClient.Get(context.Background(), key, &result); err != nil {
+			return nil
+		}
+		return &result
+	}
+
+	getJobImage := func(job *InitJob) string {
+		result := getJob(job)
+		Expect(result).ShouldNot(BeNil())
+		return result.Spec.Template.Spec.Containers[0].Image
+	}
+
+	completeJobs := func() {
+		var jobs batchv1.JobList
+		Expect(k8sClient.List(context.Background(), &jobs)).To(Succeed())
+		for i := range jobs.Items {
+			job := &jobs.Items[i]
+			if job.Status.Succeeded == 0 {
+				job.Status.Succeeded = 1
+				Expect(k8sClient.Status().Update(context.Background(), job)).To(Succeed())
+			}
+		}
+	}
+
+	// releaseSpyt completes the release jobs until the release is finished.
+	releaseSpyt := func() {
+		for i := 0; i < 10; i++ {
+			status := syncSpyt()
+			if status.SyncStatus == SyncStatusReady {
+				return
+			}
+			Expect(status.SyncStatus).Should(Equal(SyncStatusBlocked))
+			completeJobs()
+		}
+		Fail("Spyt is not released")
+	}
+
+	BeforeEach(func() {
+		ytsaurusSpec = &v1.Ytsaurus{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ytsaurus",
+				Namespace: "default",
+			},
+			Spec: v1.YtsaurusSpec{
+				CommonSpec: v1.CommonSpec{
+					CoreImage: "ytsaurus/ytsaurus:latest",
+				},
+			},
+			Status: v1.YtsaurusStatus{
+				State: v1.ClusterStateRunning,
+			},
+		}
+
+		resource = &v1.Spyt{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "spyt",
+				Namespace: "default",
+			},
+			Spec: v1.SpytSpec{
+				Ytsaurus: &corev1.LocalObjectReference{Name: "ytsaurus"},
+				Image:    "ytsaurus/spyt:1",
+			},
+		}
+
+		scheme = runtime.NewScheme()
+		Expect(v1.AddToScheme(scheme)).To(Succeed())
+		Expect(batchv1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		k8sClient = fake.NewClientBuilder().WithScheme(scheme).WithObjects(ytsaurusSpec, resource).Build()
+
+		// The fake client does not convert string data of secrets, so the token is created in advance.
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      newSpyt().secret.Name(),
+				Namespace: "default",
+			},
+			Data: map[string][]byte{consts.TokenSecretKey: []byte("token")},
+		}
+		Expect(k8sClient.Create(context.Background(), secret)).To(Succeed())
+	})
+
+	It("First release is recorded", func() {
+		releaseSpyt()
+
+		Expect(getJobImage(newSpyt().initEnvironment)).Should(Equal("ytsaurus/spyt:1"))
+		Expect(resource.Status.ReleaseStatus).Should(Equal(v1.SpytReleaseStatusFinished))
+		Expect(resource.Status.ObservedImage).Should(Equal("ytsaurus/spyt:1"))
+		Expect(resource.Status.ReleasingImage).Should(BeEmpty())
+		Expect(resource.Status.ReleasedImages).Should(Equal([]string{"ytsaurus/spyt:1"}))
+	})
+
+	It("New image is released and the previous release is kept", func() {
+		releaseSpyt()
+
+		resource.Spec.Image = "ytsaurus/spyt:2"
+		Expect(syncSpyt().SyncStatus).Should(Equal(SyncStatusBlocked))
+		Expect(resource.Status.ReleaseStatus).Should(Equal(v1.SpytReleaseStatusUploadingIntoCypress))
+		Expect(resource.Status.ReleasingImage).Should(Equal("ytsaurus/spyt:2"))
+
+		spyt := newSpyt()
+		Expect(getJobImage(spyt.initEnvironment)).Should(Equal("ytsaurus/spyt:2"))
+		// The new version becomes the default one after it is published.
+		Expect(spyt.createInitScript()).Should(ContainSubstring("yt set //home/spark/conf/global/latest_spyt_version"))
+
+		releaseSpyt()
+		Expect(resource.Status.ObservedImage).Should(Equal("ytsaurus/spyt:2"))
+		Expect(resource.Status.ReleasedImages).Should(Equal([]string{"ytsaurus/spyt:1", "ytsaurus/spyt:2"}))
+	})
+
+	It("Image changed during a release is released after it", func() {
+		releaseSpyt()
+
+		resource.Spec.Image = "ytsaurus/spyt:2"
+		Expect(syncSpyt().SyncStatus).Should(Equal(SyncStatusBlocked))
+
+		// The running release keeps its image.
+		resource.Spec.Image = "ytsaurus/spyt:3"
+		Expect(syncSpyt().SyncStatus).Should(Equal(SyncStatusBlocked))
+		Expect(resource.Status.ReleasingImage).Should(Equal("ytsaurus/spyt:2"))
+		Expect(getJobImage(newSpyt().initEnvironment)).Should(Equal("ytsaurus/spyt:2"))
+
+		releaseSpyt()
+		Expect(resource.Status.ObservedImage).Should(Equal("ytsaurus/spyt:2"))
+		Expect(resource.Status.ReleasedImages).Should(Equal([]string{"ytsaurus/spyt:1", "ytsaurus/spyt:2"}))
+
+		releaseSpyt()
+		Expect(getJobImage(newSpyt().initEnvironment)).Should(Equal("ytsaurus/spyt:3"))
+		Expect(resource.Status.ObservedImage).Should(Equal("ytsaurus/spyt:3"))
+		Expect(resource.Status.ReleasingImage).Should(BeEmpty())
+		Expect(resource.Status.ReleasedImages).Should(Equal(
+			[]string{"ytsaurus/spyt:1", "ytsaurus/spyt:2", "ytsaurus/spyt:3"}))
+	})
+})
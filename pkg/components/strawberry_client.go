@@ -0,0 +1,81 @@
+package components
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
+)
+
+// StrawberryClient calls the HTTP API of the strawberry controller,
+// the same API is used by `yt clickhouse ctl`.
+type StrawberryClient struct {
+	address    string
+	cluster    string
+	family     string
+	token      string
+	httpClient *http.Client
+}
+
+func NewStrawberryClient(cfgen *ytconfig.Generator, family, token string) *StrawberryClient {
+	return &StrawberryClient{
+		address: fmt.Sprintf("http://%s", cfgen.GetStrawberryControllerServiceAddress()),
+		// The controller serves location proxies from its config, the operator configures the only one.
+		cluster:    cfgen.GetHTTPProxiesAddress(consts.DefaultHTTPProxyRole),
+		family:     family,
+		token:      token,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+type strawberryError struct {
+	Message string `json:"message"`
+}
+
+type strawberryResponse struct {
+	Result json.RawMessage  `json:"result"`
+	Error  *strawberryError `json:"error"`
+}
+
+func (c *StrawberryClient) Call(ctx context.Context, command string, params map[string]interface{}, result interface{}) error {
+	body, err := json.Marshal(map[string]interface{}{"params": params})
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/%s/%s/%s", c.address, c.cluster, c.family, command)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Authorization", fmt.Sprintf("OAuth %s", c.token))
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	var decoded strawberryResponse
+	if err = json.NewDecoder(response.Body).Decode(&decoded); err != nil {
+		return fmt.Errorf("failed to decode response of strawberry command %s (status %d): %w", command, response.StatusCode, err)
+	}
+	if decoded.Error != nil {
+		return fmt.Errorf("strawberry command %s failed: %s", command, decoded.Error.Message)
+	}
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("strawberry command %s failed with status %d", command, response.StatusCode)
+	}
+
+	if result == nil || len(decoded.Result) == 0 {
+		return nil
+	}
+	return json.Unmarshal(decoded.Result, result)
+}
@@ -123,6 +123,10 @@ func (c *strawberryController) IsUpdatable() bool {
 	return true
 }
 
+func (c *strawberryController) getRobotToken() robotToken {
+	return newRobotToken(consts.StrawberryControllerUserName, c.secret)
+}
+
 func (c *strawberryController) Fetch(ctx context.Context) error {
 	return resources.Fetch(ctx, []resources.Fetchable{
 		c.microservice,
@@ -182,6 +186,7 @@ func (c *strawberryController) syncComponents(ctx context.Context) (err error) {
 	service.Spec.Type = "ClusterIP"
 
 	deployment := c.microservice.buildDeployment()
+	addRobotTokenAnnotation(&deployment.Spec.Template, c.secret)
 	volumeMounts := []corev1.VolumeMount{
 		createConfigVolumeMount(),
 	}
@@ -273,7 +278,7 @@ func (c *strawberryController) doSync(ctx context.Context, dry bool) (ComponentS
 		return status, err
 	}
 
-	if c.microservice.needSync() {
+	if c.microservice.needSync() || needRobotTokenRollout(c.microservice.getPodTemplate(), c.secret) {
 		if !dry {
 			// TODO(psushin): there should be me more sophisticated logic for version updates.
 			err = c.syncComponents(ctx)
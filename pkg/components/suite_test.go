@@ -8,6 +8,7 @@ import (
 	mock_yt "github.com/ytsaurus/yt-k8s-operator/pkg/mock"
 	"go.ytsaurus.tech/yt/go/yt"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	"os"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -111,6 +112,10 @@ func (fs *FakeServer) rebuildStatefulSet() *appsv1.StatefulSet {
 	return nil
 }
 
+func (fs *FakeServer) getPodTemplate() *corev1.PodTemplateSpec {
+	return &corev1.PodTemplateSpec{}
+}
+
 func (fs *FakeServer) removePods(ctx context.Context) error {
 	return nil
 }
@@ -0,0 +1,140 @@
+package components
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/labeller"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/resources"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
+)
+
+type tabletBalancer struct {
+	componentBase
+	server          server
+	master          Component
+	initEnvironment *InitJob
+}
+
+func NewTabletBalancer(cfgen *ytconfig.Generator, ytsaurus *apiproxy.Ytsaurus, master Component) Component {
+	resource := ytsaurus.GetResource()
+	l := labeller.Labeller{
+		ObjectMeta:     &resource.ObjectMeta,
+		APIProxy:       ytsaurus.APIProxy(),
+		ComponentLabel: consts.YTComponentLabelTabletBalancer,
+		ComponentName:  "TabletBalancer",
+		MonitoringPort: consts.TabletBalancerMonitoringPort,
+	}
+
+	server := newServer(
+		&l,
+		ytsaurus,
+		&resource.Spec.TabletBalancers.InstanceSpec,
+		"/usr/bin/ytserver-tablet-balancer",
+		"ytserver-tablet-balancer.yson",
+		cfgen.GetTabletBalancerStatefulSetName(),
+		cfgen.GetTabletBalancerServiceName(),
+		cfgen.GetTabletBalancerConfig,
+	)
+
+	return &tabletBalancer{
+		componentBase: componentBase{
+			labeller: &l,
+			ytsaurus: ytsaurus,
+			cfgen:    cfgen,
+		},
+		server: server,
+		master: master,
+		initEnvironment: NewInitJob(
+			&l,
+			ytsaurus.APIProxy(),
+			ytsaurus,
+			resource.Spec.ImagePullSecrets,
+			"tablet-balancer-environment",
+			consts.ClientConfigFileName,
+			resource.Spec.CoreImage,
+			cfgen.GetNativeClientConfig),
+	}
+}
+
+func (tb *tabletBalancer) IsUpdatable() bool {
+	return true
+}
+
+func (tb *tabletBalancer) Fetch(ctx context.Context) error {
+	return resources.Fetch(ctx, []resources.Fetchable{
+		tb.server,
+		tb.initEnvironment,
+	})
+}
+
+func (tb *tabletBalancer) createInitScript() string {
+	script := []string{
+		initJobWithNativeDriverPrologue(),
+	}
+	script = append(script, createUserCommand(consts.TabletBalancerUserName, "", "", true)...)
+	script = append(script,
+		fmt.Sprintf("/usr/bin/yt create map_node %s --ignore-existing", ytconfig.TabletBalancerRootPath),
+		fmt.Sprintf("/usr/bin/yt create document %s/config --attributes '{value={}}' --ignore-existing", ytconfig.TabletBalancerRootPath),
+	)
+
+	return strings.Join(script, "\n")
+}
+
+func (tb *tabletBalancer) doSync(ctx context.Context, dry bool) (ComponentStatus, error) {
+	var err error
+
+	if tb.ytsaurus.GetClusterState() == ytv1.ClusterStateRunning && tb.server.needUpdate() {
+		return SimpleStatus(SyncStatusNeedLocalUpdate), err
+	}
+
+	if tb.ytsaurus.GetClusterState() == ytv1.ClusterStateUpdating {
+		if status, err := handleUpdatingClusterState(ctx, tb.ytsaurus, tb, &tb.componentBase, tb.server, dry); status != nil {
+			return *status, err
+		}
+	}
+
+	if tb.master.Status(ctx).SyncStatus != SyncStatusReady {
+		return WaitingStatus(SyncStatusBlocked, tb.master.GetName()), err
+	}
+
+	// Users and Cypress state must exist before the balancer starts to elect a leader.
+	if !dry {
+		tb.initEnvironment.SetInitScript(tb.createInitScript())
+	}
+	status, err := tb.initEnvironment.Sync(ctx, dry)
+	if err != nil || status.SyncStatus != SyncStatusReady {
+		return status, err
+	}
+
+	if tb.server.needSync() {
+		if !dry {
+			err = tb.server.Sync(ctx)
+		}
+		return WaitingStatus(SyncStatusPending, "components"), err
+	}
+
+	if !tb.server.arePodsReady(ctx) {
+		return WaitingStatus(SyncStatusBlocked, "pods"), err
+	}
+
+	return SimpleStatus(SyncStatusReady), err
+}
+
+func (tb *tabletBalancer) Status(ctx context.Context) ComponentStatus {
+	status, err := tb.doSync(ctx, true)
+	if err != nil {
+		panic(err)
+	}
+
+	return status
+}
+
+func (tb *tabletBalancer) Sync(ctx context.Context) error {
+	_, err := tb.doSync(ctx, false)
+	return err
+}
@@ -0,0 +1,223 @@
+package components
+
+import (
+	"context"
+	"fmt"
+
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type TabletCellBundle struct {
+	bundle   *apiproxy.TabletCellBundle
+	ytClient yt.Client
+}
+
+func NewTabletCellBundle(bundle *apiproxy.TabletCellBundle, ytClient yt.Client) *TabletCellBundle {
+	return &TabletCellBundle{
+		bundle:   bundle,
+		ytClient: ytClient,
+	}
+}
+
+func getBundleName(resource *ytv1.TabletCellBundle) string {
+	if resource.Spec.BundleName != "" {
+		return resource.Spec.BundleName
+	}
+	return resource.Name
+}
+
+// getManagedBundles returns the bundles of the cluster which are managed by TabletCellBundle resources,
+// their options are not reconciled from the bootstrap spec.
+func getManagedBundles(ctx context.Context, k8sClient client.Client, namespace, ytsaurusName string) (map[string]bool, error) {
+	var bundles ytv1.TabletCellBundleList
+	if err := k8sClient.List(ctx, &bundles, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	managed := make(map[string]bool)
+	for i := range bundles.Items {
+		bundle := &bundles.Items[i]
+		if bundle.Spec.Ytsaurus.Name == ytsaurusName && bundle.DeletionTimestamp == nil {
+			managed[getBundleName(bundle)] = true
+		}
+	}
+	return managed, nil
+}
+
+func (tcb *TabletCellBundle) getName() string {
+	return getBundleName(tcb.bundle.GetResource())
+}
+
+func (tcb *TabletCellBundle) getPath() ypath.Path {
+	return ypath.Path(fmt.Sprintf("//sys/tablet_cell_bundles/%s", tcb.getName()))
+}
+
+func (tcb *TabletCellBundle) getOptions() map[string]interface{} {
+	spec := tcb.bundle.GetResource().Spec
+	options := map[string]interface{}{
+		"changelog_account": spec.ChangelogAccount,
+		"snapshot_account":  spec.SnapshotAccount,
+	}
+
+	if spec.ChangelogPrimaryMedium != nil {
+		options["changelog_primary_medium"] = *spec.ChangelogPrimaryMedium
+	}
+	if spec.SnapshotPrimaryMedium != nil {
+		options["snapshot_primary_medium"] = *spec.SnapshotPrimaryMedium
+	}
+	if spec.ChangelogReplicationFactor != nil {
+		options["changelog_replication_factor"] = *spec.ChangelogReplicationFactor
+	}
+	if spec.SnapshotReplicationFactor != nil {
+		options["snapshot_replication_factor"] = *spec.SnapshotReplicationFactor
+	}
+
+	return options
+}
+
+func (tcb *TabletCellBundle) create(ctx context.Context) error {
+	attributes := map[string]interface{}{
+		"name":    tcb.getName(),
+		"options": tcb.getOptions(),
+	}
+	if nodeTagFilter := tcb.bundle.GetResource().Spec.NodeTagFilter; nodeTagFilter != nil {
+		attributes["node_tag_filter"] = *nodeTagFilter
+	}
+
+	_, err := tcb.ytClient.CreateObject(ctx, yt.NodeTabletCellBundle, &yt.CreateObjectOptions{
+		Attributes: attributes,
+	})
+	if err != nil {
+		return err
+	}
+
+	tcb.bundle.APIProxy().RecordNormal("Created", fmt.Sprintf("Tablet cell bundle %s was created", tcb.getName()))
+	return nil
+}
+
+func (tcb *TabletCellBundle) update(ctx context.Context) error {
+	logger := log.FromContext(ctx)
+	path := tcb.getPath()
+
+	var options map[string]interface{}
+	if err := tcb.ytClient.GetNode(ctx, path.Attr("options"), &options, nil); err != nil {
+		return err
+	}
+
+	for key, value := range tcb.getOptions() {
+		equal, err := isEqualNodeValue(options[key], value)
+		if err != nil {
+			return err
+		}
+		if equal {
+			continue
+		}
+
+		logger.Info("Updating tablet cell bundle option", "bundle", tcb.getName(), "option", key, "value", value)
+		if err := tcb.ytClient.SetNode(ctx, path.Attr("options").Child(key), value, nil); err != nil {
+			return err
+		}
+	}
+
+	if nodeTagFilter := tcb.bundle.GetResource().Spec.NodeTagFilter; nodeTagFilter != nil {
+		var currentNodeTagFilter string
+		if err := tcb.ytClient.GetNode(ctx, path.Attr("node_tag_filter"), &currentNodeTagFilter, nil); err != nil {
+			return err
+		}
+
+		if currentNodeTagFilter != *nodeTagFilter {
+			if err := tcb.ytClient.SetNode(ctx, path.Attr("node_tag_filter"), *nodeTagFilter, nil); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (tcb *TabletCellBundle) updateStatus(ctx context.Context) error {
+	resource := tcb.bundle.GetResource()
+
+	err := tcb.ytClient.GetNode(ctx, tcb.getPath().Attr("health"), &resource.Status.Health, nil)
+	if err != nil {
+		return err
+	}
+
+	return tcb.ytClient.GetNode(ctx, tcb.getPath().Attr("tablet_cell_count"), &resource.Status.TabletCellCount, nil)
+}
+
+func (tcb *TabletCellBundle) doSync(ctx context.Context) error {
+	exists, err := tcb.ytClient.NodeExists(ctx, tcb.getPath(), nil)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		err = tcb.create(ctx)
+	} else {
+		err = tcb.update(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	err = CreateTabletCells(ctx, tcb.ytClient, tcb.getName(), tcb.bundle.GetResource().Spec.TabletCellCount)
+	if err != nil {
+		return err
+	}
+
+	return tcb.updateStatus(ctx)
+}
+
+// Remove removes the tablet cells of the bundle and then the bundle itself,
+// the bundles created by the operator are kept.
+func (tcb *TabletCellBundle) Remove(ctx context.Context) error {
+	if tcb.getName() == SysBundle || tcb.getName() == DefaultBundle {
+		return nil
+	}
+
+	path := tcb.getPath()
+	exists, err := tcb.ytClient.NodeExists(ctx, path, nil)
+	if err != nil || !exists {
+		return err
+	}
+
+	var cellIDs []string
+	if err = tcb.ytClient.GetNode(ctx, path.Attr("tablet_cell_ids"), &cellIDs, nil); err != nil {
+		return err
+	}
+	for _, cellID := range cellIDs {
+		if err = tcb.ytClient.RemoveNode(ctx, ypath.Path(fmt.Sprintf("#%s", cellID)), nil); err != nil {
+			return err
+		}
+	}
+
+	removed, err := removeCypressObject(ctx, tcb.ytClient, path, nil)
+	if removed && err == nil {
+		tcb.bundle.APIProxy().RecordNormal("Removed", fmt.Sprintf("Tablet cell bundle %s was removed", tcb.getName()))
+	}
+	return err
+}
+
+func (tcb *TabletCellBundle) Fetch(ctx context.Context) error {
+	return nil
+}
+
+func (tcb *TabletCellBundle) IsReady() bool {
+	return tcb.bundle.GetResource().Status.Health == "good"
+}
+
+func (tcb *TabletCellBundle) Sync(ctx context.Context) error {
+	err := tcb.doSync(ctx)
+	setSyncedCondition(tcb.bundle, err)
+	if err == nil {
+		resource := tcb.bundle.GetResource()
+		resource.Status.ObservedGeneration = resource.Generation
+	}
+	return err
+}
@@ -123,7 +123,7 @@ func (tn *tabletNode) doSync(ctx context.Context, dry bool) (ComponentStatus, er
 						"snapshot_account":  "sys",
 					}
 
-					bootstrap := tn.getBundleBootstrap(SysBundle)
+					bootstrap := getBundleBootstrap(tn.ytsaurus.GetResource(), SysBundle)
 					if bootstrap != nil {
 						if bootstrap.ChangelogPrimaryMedium != nil {
 							options["changelog_primary_medium"] = *bootstrap.ChangelogPrimaryMedium
@@ -149,7 +149,7 @@ func (tn *tabletNode) doSync(ctx context.Context, dry bool) (ComponentStatus, er
 				return WaitingStatus(SyncStatusPending, "tablet_cell_bundle creation"), err
 			}
 
-			defaultBundleBootstrap := tn.getBundleBootstrap(DefaultBundle)
+			defaultBundleBootstrap := getBundleBootstrap(tn.ytsaurus.GetResource(), DefaultBundle)
 			if defaultBundleBootstrap != nil {
 				path := ypath.Path(fmt.Sprintf("//sys/tablet_cell_bundles/%s", DefaultBundle))
 				if defaultBundleBootstrap.ChangelogPrimaryMedium != nil {
@@ -171,7 +171,7 @@ func (tn *tabletNode) doSync(ctx context.Context, dry bool) (ComponentStatus, er
 
 			for _, bundle := range []string{DefaultBundle, SysBundle} {
 				tabletCellCount := 1
-				bootstrap := tn.getBundleBootstrap(bundle)
+				bootstrap := getBundleBootstrap(tn.ytsaurus.GetResource(), bundle)
 				if bootstrap != nil {
 					tabletCellCount = bootstrap.TabletCellCount
 				}
@@ -193,8 +193,7 @@ func (tn *tabletNode) doSync(ctx context.Context, dry bool) (ComponentStatus, er
 	return WaitingStatus(SyncStatusPending, fmt.Sprintf("setting %s condition", tn.initBundlesCondition)), err
 }
 
-func (tn *tabletNode) getBundleBootstrap(bundle string) *ytv1.BundleBootstrapSpec {
-	resource := tn.ytsaurus.GetResource()
+func getBundleBootstrap(resource *ytv1.Ytsaurus, bundle string) *ytv1.BundleBootstrapSpec {
 	if resource.Spec.Bootstrap == nil || resource.Spec.Bootstrap.TabletCellBundles == nil {
 		return nil
 	}
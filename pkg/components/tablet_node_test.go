@@ -47,7 +47,9 @@ var _ = Describe("Tablet node test", func() {
 				Namespace: namespace,
 			},
 			Spec: v1.YtsaurusSpec{
-				CoreImage: "ytsaurus/ytsaurus:latest",
+				CommonSpec: v1.CommonSpec{
+					CoreImage: "ytsaurus/ytsaurus:latest",
+				},
 				Discovery: v1.DiscoverySpec{
 					InstanceSpec: v1.InstanceSpec{
 						InstanceCount: 1,
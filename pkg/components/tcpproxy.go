@@ -47,13 +47,15 @@ func NewTCPProxy(
 			return cfgen.GetTCPProxyConfig(spec)
 		},
 	)
+	server.addPublicPorts(consts.TCPProxyMinTCPPort, consts.TCPProxyMaxTCPPort)
 
 	var balancingService *resources.TCPService = nil
 	if spec.ServiceType != nil {
 		balancingService = resources.NewTCPService(
 			cfgen.GetTCPProxiesServiceName(spec.Role),
 			&l,
-			ytsaurus.APIProxy())
+			ytsaurus.APIProxy(),
+			&resource.Spec.CommonSpec)
 	}
 
 	return &tcpProxy{
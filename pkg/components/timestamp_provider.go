@@ -0,0 +1,104 @@
+package components
+
+import (
+	"context"
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/labeller"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/resources"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
+)
+
+type timestampProvider struct {
+	componentBase
+	server server
+	master Component
+}
+
+func NewTimestampProvider(cfgen *ytconfig.Generator, ytsaurus *apiproxy.Ytsaurus, master Component) Component {
+	resource := ytsaurus.GetResource()
+	l := labeller.Labeller{
+		ObjectMeta:     &resource.ObjectMeta,
+		APIProxy:       ytsaurus.APIProxy(),
+		ComponentLabel: consts.YTComponentLabelTimestampProvider,
+		ComponentName:  "TimestampProvider",
+		MonitoringPort: consts.TimestampProviderMonitoringPort,
+	}
+
+	server := newServer(
+		&l,
+		ytsaurus,
+		&resource.Spec.TimestampProviders.InstanceSpec,
+		"/usr/bin/ytserver-timestamp-provider",
+		"ytserver-timestamp-provider.yson",
+		cfgen.GetTimestampProvidersStatefulSetName(),
+		cfgen.GetTimestampProvidersServiceName(),
+		cfgen.GetTimestampProviderConfig,
+	)
+
+	return &timestampProvider{
+		componentBase: componentBase{
+			labeller: &l,
+			ytsaurus: ytsaurus,
+			cfgen:    cfgen,
+		},
+		server: server,
+		master: master,
+	}
+}
+
+func (tsp *timestampProvider) IsUpdatable() bool {
+	return true
+}
+
+func (tsp *timestampProvider) Fetch(ctx context.Context) error {
+	return resources.Fetch(ctx, []resources.Fetchable{
+		tsp.server,
+	})
+}
+
+func (tsp *timestampProvider) doSync(ctx context.Context, dry bool) (ComponentStatus, error) {
+	var err error
+
+	if tsp.ytsaurus.GetClusterState() == ytv1.ClusterStateRunning && tsp.server.needUpdate() {
+		return SimpleStatus(SyncStatusNeedLocalUpdate), err
+	}
+
+	if tsp.ytsaurus.GetClusterState() == ytv1.ClusterStateUpdating {
+		if status, err := handleUpdatingClusterState(ctx, tsp.ytsaurus, tsp, &tsp.componentBase, tsp.server, dry); status != nil {
+			return *status, err
+		}
+	}
+
+	if tsp.master.Status(ctx).SyncStatus != SyncStatusReady {
+		return WaitingStatus(SyncStatusBlocked, tsp.master.GetName()), err
+	}
+
+	if tsp.server.needSync() {
+		if !dry {
+			err = tsp.server.Sync(ctx)
+		}
+		return WaitingStatus(SyncStatusPending, "components"), err
+	}
+
+	if !tsp.server.arePodsReady(ctx) {
+		return WaitingStatus(SyncStatusBlocked, "pods"), err
+	}
+
+	return SimpleStatus(SyncStatusReady), err
+}
+
+func (tsp *timestampProvider) Status(ctx context.Context) ComponentStatus {
+	status, err := tsp.doSync(ctx, true)
+	if err != nil {
+		panic(err)
+	}
+
+	return status
+}
+
+func (tsp *timestampProvider) Sync(ctx context.Context) error {
+	_, err := tsp.doSync(ctx, false)
+	return err
+}
@@ -18,15 +18,18 @@ import (
 
 type UI struct {
 	componentBase
-	microservice microservice
-	initJob      *InitJob
-	master       Component
-	secret       *resources.StringSecret
+	microservice   microservice
+	initJob        *InitJob
+	master         Component
+	secret         *resources.StringSecret
+	externalAccess *externalAccess
 }
 
 const UIClustersConfigFileName = "clusters-config.json"
 const UICustomConfigFileName = "common.js"
 
+const uiServiceName = "ytsaurus-ui"
+
 func NewUI(cfgen *ytconfig.Generator, ytsaurus *apiproxy.Ytsaurus, master Component) Component {
 	r := ytsaurus.GetResource()
 	l := labeller.Labeller{
@@ -57,7 +60,15 @@ func NewUI(cfgen *ytconfig.Generator, ytsaurus *apiproxy.Ytsaurus, master Compon
 			},
 		},
 		"ytsaurus-ui-deployment",
-		"ytsaurus-ui")
+		uiServiceName)
+	microservice.addPublicPorts(consts.UIHTTPPort, consts.UIHTTPPort)
+
+	ea := newExternalAccess(
+		r.Spec.UI.ExternalAccess,
+		uiServiceName,
+		consts.UIHTTPPort,
+		&l,
+		ytsaurus.APIProxy())
 
 	return &UI{
 		componentBase: componentBase{
@@ -79,7 +90,8 @@ func NewUI(cfgen *ytconfig.Generator, ytsaurus *apiproxy.Ytsaurus, master Compon
 			l.GetSecretName(),
 			&l,
 			ytsaurus.APIProxy()),
-		master: master,
+		master:         master,
+		externalAccess: ea,
 	}
 }
 
@@ -87,13 +99,27 @@ func (u *UI) IsUpdatable() bool {
 	return true
 }
 
-func (u *UI) Fetch(ctx context.Context) error {
+func (u *UI) getRobotToken() robotToken {
+	token := newRobotToken(consts.UIUserName, u.secret)
+	token.buildData = buildUISecretData
+	return token
+}
 
-	return resources.Fetch(ctx, []resources.Fetchable{
+func buildUISecretData(token string) map[string]string {
+	return map[string]string{
+		consts.UISecretFileName: fmt.Sprintf("{\"oauthToken\" : \"%s\"}", token),
+		consts.TokenSecretKey:   token,
+	}
+}
+
+func (u *UI) Fetch(ctx context.Context) error {
+	fetchable := []resources.Fetchable{
 		u.microservice,
 		u.initJob,
 		u.secret,
-	})
+		u.externalAccess,
+	}
+	return resources.Fetch(ctx, fetchable)
 }
 
 func (u *UI) initUser() string {
@@ -159,10 +185,21 @@ func (u *UI) syncComponents(ctx context.Context) (err error) {
 		})
 	}
 
+	if auth := ytsaurusResource.Spec.Authentication; auth != nil && auth.Oidc != nil {
+		// Other OAuth settings are set in the custom config.
+		env = append(env, corev1.EnvVar{
+			Name: "YT_OAUTH_CLIENT_SECRET",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: auth.Oidc.ClientSecret.DeepCopy(),
+			},
+		})
+	}
+
 	env = append(env, ytsaurusResource.Spec.UI.ExtraEnvVariables...)
 
 	secretsVolumeSize, _ := resource.ParseQuantity("1Mi")
 	deployment := u.microservice.buildDeployment()
+	addRobotTokenAnnotation(&deployment.Spec.Template, u.secret)
 	deployment.Spec.Template.Spec.InitContainers = []corev1.Container{
 		{
 			Image: u.microservice.getImage(),
@@ -251,12 +288,8 @@ func (u *UI) doSync(ctx context.Context, dry bool) (ComponentStatus, error) {
 
 	if u.secret.NeedSync(consts.TokenSecretKey, "") {
 		if !dry {
-			token := ytconfig.RandString(30)
 			s := u.secret.Build()
-			s.StringData = map[string]string{
-				consts.UISecretFileName: fmt.Sprintf("{\"oauthToken\" : \"%s\"}", token),
-				consts.TokenSecretKey:   token,
-			}
+			s.StringData = buildUISecretData(ytconfig.RandString(30))
 			err = u.secret.Sync(ctx)
 		}
 		return WaitingStatus(SyncStatusPending, u.secret.Name()), err
@@ -270,13 +303,20 @@ func (u *UI) doSync(ctx context.Context, dry bool) (ComponentStatus, error) {
 		return status, err
 	}
 
-	if u.microservice.needSync() {
+	if u.microservice.needSync() || needRobotTokenRollout(u.microservice.getPodTemplate(), u.secret) {
 		if !dry {
 			err = u.syncComponents(ctx)
 		}
 		return WaitingStatus(SyncStatusPending, "components"), err
 	}
 
+	if u.externalAccess.needSync() {
+		if !dry {
+			err = u.externalAccess.Sync(ctx)
+		}
+		return WaitingStatus(SyncStatusPending, u.externalAccess.Name()), err
+	}
+
 	if !u.microservice.arePodsReady(ctx) {
 		return WaitingStatus(SyncStatusPending, "pods"), err
 	}
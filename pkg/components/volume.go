@@ -51,6 +51,24 @@ func createConfigVolume(configMapName string, mode *int32) v1.Volume {
 	}
 }
 
+// createSecretKeyVolume creates a volume with a single key of the secret, the file is named after the key.
+func createSecretKeyVolume(name string, selector v1.SecretKeySelector) v1.Volume {
+	return v1.Volume{
+		Name: name,
+		VolumeSource: v1.VolumeSource{
+			Secret: &v1.SecretVolumeSource{
+				SecretName: selector.Name,
+				Items: []v1.KeyToPath{
+					{
+						Key:  selector.Key,
+						Path: selector.Key,
+					},
+				},
+			},
+		},
+	}
+}
+
 func createVolumes(specVolumes []v1.Volume, configMapName string) []v1.Volume {
 	volumes := make([]v1.Volume, 0, len(specVolumes)+1)
 	volumes = append(volumes, specVolumes...)
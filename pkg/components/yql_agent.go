@@ -76,6 +76,10 @@ func (yqla *yqlAgent) GetName() string {
 	return yqla.labeller.ComponentName
 }
 
+func (yqla *yqlAgent) getRobotToken() robotToken {
+	return newRobotToken(consts.YqlUserName, yqla.secret)
+}
+
 func (yqla *yqlAgent) Fetch(ctx context.Context) error {
 	return resources.Fetch(ctx, []resources.Fetchable{
 		yqla.server,
@@ -141,9 +145,10 @@ func (yqla *yqlAgent) doSync(ctx context.Context, dry bool) (ComponentStatus, er
 		return WaitingStatus(SyncStatusPending, yqla.secret.Name()), err
 	}
 
-	if yqla.server.needSync() {
+	if yqla.server.needSync() || needRobotTokenRollout(yqla.server.getPodTemplate(), yqla.secret) {
 		if !dry {
 			ss := yqla.server.buildStatefulSet()
+			addRobotTokenAnnotation(&ss.Spec.Template, yqla.secret)
 			container := &ss.Spec.Template.Spec.Containers[0]
 			container.EnvFrom = []corev1.EnvFromSource{yqla.secret.GetEnvSource()}
 			container.Env = []corev1.EnvVar{{Name: "YT_FORCE_IPV4", Value: "1"}, {Name: "YT_FORCE_IPV6", Value: "0"}}
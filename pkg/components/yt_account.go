@@ -0,0 +1,195 @@
+package components
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const rootAccount = "root"
+
+type accountResources struct {
+	DiskSpacePerMedium map[string]int64 `yson:"disk_space_per_medium"`
+	NodeCount          int64            `yson:"node_count"`
+	ChunkCount         int64            `yson:"chunk_count"`
+	TabletCount        int64            `yson:"tablet_count"`
+	TabletStaticMemory int64            `yson:"tablet_static_memory"`
+}
+
+func (r *accountResources) toStatus() ytv1.AccountResources {
+	result := ytv1.AccountResources{
+		NodeCount:          &r.NodeCount,
+		ChunkCount:         &r.ChunkCount,
+		TabletCount:        &r.TabletCount,
+		TabletStaticMemory: resource.NewQuantity(r.TabletStaticMemory, resource.BinarySI),
+	}
+	if len(r.DiskSpacePerMedium) != 0 {
+		result.DiskSpacePerMedium = make(map[string]resource.Quantity)
+		for medium, diskSpace := range r.DiskSpacePerMedium {
+			result.DiskSpacePerMedium[medium] = *resource.NewQuantity(diskSpace, resource.BinarySI)
+		}
+	}
+	return result
+}
+
+type YtAccount struct {
+	account  *apiproxy.YtAccount
+	ytsaurus *ytv1.Ytsaurus
+	ytClient yt.Client
+}
+
+func NewYtAccount(account *apiproxy.YtAccount, ytsaurus *ytv1.Ytsaurus, ytClient yt.Client) *YtAccount {
+	return &YtAccount{
+		account:  account,
+		ytsaurus: ytsaurus,
+		ytClient: ytClient,
+	}
+}
+
+func (a *YtAccount) getName() string {
+	resource := a.account.GetResource()
+	if resource.Spec.AccountName != "" {
+		return resource.Spec.AccountName
+	}
+	return resource.Name
+}
+
+func (a *YtAccount) getParentName() string {
+	if parent := a.account.GetResource().Spec.ParentAccount; parent != "" {
+		return parent
+	}
+	return rootAccount
+}
+
+func (a *YtAccount) getPath() ypath.Path {
+	return ypath.Path(fmt.Sprintf("//sys/accounts/%s", a.getName()))
+}
+
+// validateMedia checks that disk space limits refer to media declared in data node locations.
+func (a *YtAccount) validateMedia() error {
+	media := map[string]bool{consts.DefaultMedium: true}
+	for _, medium := range getExtraMedia(a.ytsaurus) {
+		media[medium.Name] = true
+	}
+
+	var unknown []string
+	for medium := range a.account.GetResource().Spec.ResourceLimits.DiskSpacePerMedium {
+		if !media[medium] {
+			unknown = append(unknown, medium)
+		}
+	}
+	if len(unknown) != 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("media %v are not declared in data node locations", unknown)
+	}
+
+	return nil
+}
+
+func (a *YtAccount) getResourceLimits() map[string]interface{} {
+	limits := a.account.GetResource().Spec.ResourceLimits
+	attributes := make(map[string]interface{})
+
+	for medium, diskSpace := range limits.DiskSpacePerMedium {
+		attributes[fmt.Sprintf("disk_space_per_medium/%s", medium)] = diskSpace.Value()
+	}
+	if limits.NodeCount != nil {
+		attributes["node_count"] = *limits.NodeCount
+	}
+	if limits.ChunkCount != nil {
+		attributes["chunk_count"] = *limits.ChunkCount
+	}
+	if limits.TabletCount != nil {
+		attributes["tablet_count"] = *limits.TabletCount
+	}
+	if limits.TabletStaticMemory != nil {
+		attributes["tablet_static_memory"] = limits.TabletStaticMemory.Value()
+	}
+
+	return attributes
+}
+
+func (a *YtAccount) updateStatus(ctx context.Context) error {
+	status := &a.account.GetResource().Status
+
+	var usage, limits accountResources
+	if err := a.ytClient.GetNode(ctx, a.getPath().Attr("resource_usage"), &usage, nil); err != nil {
+		return err
+	}
+	if err := a.ytClient.GetNode(ctx, a.getPath().Attr("resource_limits"), &limits, nil); err != nil {
+		return err
+	}
+
+	status.ResourceUsage = usage.toStatus()
+	status.ResourceLimits = limits.toStatus()
+	return nil
+}
+
+func (a *YtAccount) doSync(ctx context.Context) error {
+	if err := a.validateMedia(); err != nil {
+		return err
+	}
+
+	exists, err := a.ytClient.NodeExists(ctx, a.getPath(), nil)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		_, err = a.ytClient.CreateObject(ctx, yt.NodeAccount, &yt.CreateObjectOptions{
+			Attributes: map[string]interface{}{
+				"name":        a.getName(),
+				"parent_name": a.getParentName(),
+			},
+		})
+		if err != nil {
+			return err
+		}
+		a.account.APIProxy().RecordNormal("Created", fmt.Sprintf("Account %s was created", a.getName()))
+	} else if err = syncNodeAttribute(ctx, a.ytClient, a.getPath().Attr("parent_name"), a.getParentName()); err != nil {
+		return err
+	}
+
+	// Limits are set one by one, so that limits missing in the spec are left as is.
+	for key, value := range a.getResourceLimits() {
+		if err = syncNodeAttribute(ctx, a.ytClient, a.getPath().Attr("resource_limits").Child(key), value); err != nil {
+			return err
+		}
+	}
+
+	return a.updateStatus(ctx)
+}
+
+// Remove removes the account, it fails while the account has child accounts or is used by any node.
+func (a *YtAccount) Remove(ctx context.Context) error {
+	removed, err := removeCypressObject(ctx, a.ytClient, a.getPath(), nil)
+	if removed && err == nil {
+		a.account.APIProxy().RecordNormal("Removed", fmt.Sprintf("Account %s was removed", a.getName()))
+	}
+	return err
+}
+
+func (a *YtAccount) Fetch(ctx context.Context) error {
+	return nil
+}
+
+func (a *YtAccount) IsReady() bool {
+	return true
+}
+
+func (a *YtAccount) Sync(ctx context.Context) error {
+	err := a.doSync(ctx)
+	setSyncedCondition(a.account, err)
+	if err == nil {
+		resource := a.account.GetResource()
+		resource.Status.ObservedGeneration = resource.Generation
+	}
+	return err
+}
@@ -0,0 +1,135 @@
+package components
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+	"k8s.io/utils/strings/slices"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type YtGroup struct {
+	group    *apiproxy.YtGroup
+	ytClient yt.Client
+}
+
+func NewYtGroup(group *apiproxy.YtGroup, ytClient yt.Client) *YtGroup {
+	return &YtGroup{
+		group:    group,
+		ytClient: ytClient,
+	}
+}
+
+func (g *YtGroup) getName() string {
+	resource := g.group.GetResource()
+	if resource.Spec.GroupName != "" {
+		return resource.Spec.GroupName
+	}
+	return resource.Name
+}
+
+func (g *YtGroup) getPath() ypath.Path {
+	return ypath.Path(fmt.Sprintf("//sys/groups/%s", g.getName()))
+}
+
+func (g *YtGroup) syncMembers(ctx context.Context) error {
+	logger := log.FromContext(ctx)
+
+	var members []string
+	if err := g.ytClient.GetNode(ctx, g.getPath().Attr("members"), &members, nil); err != nil {
+		return err
+	}
+
+	expected := g.group.GetResource().Spec.Members
+	for _, member := range expected {
+		if slices.Contains(members, member) {
+			continue
+		}
+		logger.Info("Adding group member", "group", g.getName(), "member", member)
+		if err := g.ytClient.AddMember(ctx, g.getName(), member, nil); err != nil {
+			return err
+		}
+	}
+
+	for _, member := range members {
+		if slices.Contains(expected, member) {
+			continue
+		}
+		logger.Info("Removing group member", "group", g.getName(), "member", member)
+		if err := g.ytClient.RemoveMember(ctx, g.getName(), member, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *YtGroup) doSync(ctx context.Context) error {
+	exists, err := g.ytClient.NodeExists(ctx, g.getPath(), nil)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		_, err = g.ytClient.CreateObject(ctx, yt.NodeGroup, &yt.CreateObjectOptions{
+			IgnoreExisting: true,
+			Attributes: map[string]interface{}{
+				"name": g.getName(),
+			},
+		})
+		if err != nil {
+			return err
+		}
+		g.group.APIProxy().RecordNormal("Created", fmt.Sprintf("Group %s was created", g.getName()))
+	}
+
+	if err = g.syncMembers(ctx); err != nil {
+		return err
+	}
+
+	resource := g.group.GetResource()
+	paths, err := syncSubjectACL(ctx, g.ytClient, g.getName(), resource.Spec.ACL, resource.Status.ACLPaths)
+	if err != nil {
+		return err
+	}
+	resource.Status.ACLPaths = paths
+
+	return nil
+}
+
+// Remove revokes the access control entries of the group and removes it.
+func (g *YtGroup) Remove(ctx context.Context) error {
+	resource := g.group.GetResource()
+	paths, err := syncSubjectACL(ctx, g.ytClient, g.getName(), nil, resource.Status.ACLPaths)
+	if err != nil {
+		return err
+	}
+	resource.Status.ACLPaths = paths
+
+	removed, err := removeCypressObject(ctx, g.ytClient, g.getPath(), nil)
+	if removed && err == nil {
+		g.group.APIProxy().RecordNormal("Removed", fmt.Sprintf("Group %s was removed", g.getName()))
+	}
+	return err
+}
+
+func (g *YtGroup) Fetch(ctx context.Context) error {
+	return nil
+}
+
+func (g *YtGroup) IsReady() bool {
+	return true
+}
+
+func (g *YtGroup) Sync(ctx context.Context) error {
+	err := g.doSync(ctx)
+	setSyncedCondition(g.group, err)
+	if err == nil {
+		resource := g.group.GetResource()
+		resource.Status.ObservedGeneration = resource.Generation
+	}
+	return err
+}
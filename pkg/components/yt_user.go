@@ -0,0 +1,178 @@
+package components
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/labeller"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/resources"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/ytconfig"
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+)
+
+type YtUser struct {
+	labeller *labeller.Labeller
+	user     *apiproxy.YtUser
+	ytClient yt.Client
+
+	secret *resources.StringSecret
+}
+
+func NewYtUser(user *apiproxy.YtUser, ytClient yt.Client) *YtUser {
+	resource := user.GetResource()
+	l := labeller.Labeller{
+		ObjectMeta:     &resource.ObjectMeta,
+		APIProxy:       user.APIProxy(),
+		ComponentLabel: fmt.Sprintf("yt-user-%s", resource.Name),
+		ComponentName:  fmt.Sprintf("YtUser-%s", resource.Name),
+	}
+
+	secretName := l.GetSecretName()
+	if resource.Spec.TokenSecretName != "" {
+		secretName = resource.Spec.TokenSecretName
+	}
+	// The secret of the previously generated token is fetched to revoke the token.
+	if !resource.Spec.GenerateToken && resource.Status.TokenSecretName != "" {
+		secretName = resource.Status.TokenSecretName
+	}
+
+	return &YtUser{
+		labeller: &l,
+		user:     user,
+		ytClient: ytClient,
+		secret: resources.NewStringSecret(
+			secretName,
+			&l,
+			user.APIProxy()),
+	}
+}
+
+func (u *YtUser) getName() string {
+	resource := u.user.GetResource()
+	if resource.Spec.UserName != "" {
+		return resource.Spec.UserName
+	}
+	return resource.Name
+}
+
+func (u *YtUser) getPath() ypath.Path {
+	return ypath.Path(fmt.Sprintf("//sys/users/%s", u.getName()))
+}
+
+func (u *YtUser) Fetch(ctx context.Context) error {
+	return resources.Fetch(ctx, []resources.Fetchable{u.secret})
+}
+
+func (u *YtUser) syncToken(ctx context.Context) (string, error) {
+	if u.secret.NeedSync(consts.TokenSecretKey, "") {
+		token, err := ytconfig.RandSecureString(30)
+		if err != nil {
+			return "", err
+		}
+		s := u.secret.Build()
+		s.StringData = map[string]string{
+			consts.TokenSecretKey: token,
+		}
+		if err := u.secret.Sync(ctx); err != nil {
+			return "", err
+		}
+		return s.StringData[consts.TokenSecretKey], nil
+	}
+
+	token, _ := u.secret.GetValue(consts.TokenSecretKey)
+	return token, nil
+}
+
+// revokeToken removes the generated token from Cypress together with its secret.
+func (u *YtUser) revokeToken(ctx context.Context) error {
+	token, ok := u.secret.GetValue(consts.TokenSecretKey)
+	if !ok || !isControlledByCluster(u.secret.OldObject(), u.labeller) {
+		return nil
+	}
+
+	tokenPath := ypath.Path(fmt.Sprintf("//sys/cypress_tokens/%s", sha256String(token)))
+	if err := u.ytClient.RemoveNode(ctx, tokenPath, &yt.RemoveNodeOptions{Force: true}); err != nil {
+		return err
+	}
+	if err := removeObject(ctx, u.secret, u.labeller); err != nil {
+		return err
+	}
+
+	u.user.APIProxy().RecordNormal("TokenRevoked", fmt.Sprintf("Token of user %s was revoked", u.getName()))
+	return nil
+}
+
+func (u *YtUser) doSync(ctx context.Context) error {
+	resource := u.user.GetResource()
+
+	token := ""
+	if resource.Spec.GenerateToken {
+		var err error
+		if token, err = u.syncToken(ctx); err != nil {
+			return err
+		}
+		resource.Status.TokenSecretName = u.secret.Name()
+	} else {
+		if err := u.revokeToken(ctx); err != nil {
+			return err
+		}
+		resource.Status.TokenSecretName = ""
+	}
+
+	exists, err := u.ytClient.NodeExists(ctx, u.getPath(), nil)
+	if err != nil {
+		return err
+	}
+
+	if err = CreateUserCommand(ctx, u.ytClient, u.getName(), token, false); err != nil {
+		return err
+	}
+	if !exists {
+		u.user.APIProxy().RecordNormal("Created", fmt.Sprintf("User %s was created", u.getName()))
+	}
+
+	paths, err := syncSubjectACL(ctx, u.ytClient, u.getName(), resource.Spec.ACL, resource.Status.ACLPaths)
+	if err != nil {
+		return err
+	}
+	resource.Status.ACLPaths = paths
+
+	return nil
+}
+
+// Remove revokes the access control entries of the user and removes it.
+func (u *YtUser) Remove(ctx context.Context) error {
+	resource := u.user.GetResource()
+	paths, err := syncSubjectACL(ctx, u.ytClient, u.getName(), nil, resource.Status.ACLPaths)
+	if err != nil {
+		return err
+	}
+	resource.Status.ACLPaths = paths
+
+	if err = u.revokeToken(ctx); err != nil {
+		return err
+	}
+
+	removed, err := removeCypressObject(ctx, u.ytClient, u.getPath(), nil)
+	if removed && err == nil {
+		u.user.APIProxy().RecordNormal("Removed", fmt.Sprintf("User %s was removed", u.getName()))
+	}
+	return err
+}
+
+func (u *YtUser) IsReady() bool {
+	return true
+}
+
+func (u *YtUser) Sync(ctx context.Context) error {
+	err := u.doSync(ctx)
+	setSyncedCondition(u.user, err)
+	if err == nil {
+		resource := u.user.GetResource()
+		resource.Status.ObservedGeneration = resource.Generation
+	}
+	return err
+}
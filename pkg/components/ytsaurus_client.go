@@ -74,6 +74,10 @@ func (yc *ytsaurusClient) IsUpdatable() bool {
 	return false
 }
 
+func (yc *ytsaurusClient) getRobotToken() robotToken {
+	return newRobotToken(consts.YtsaurusOperatorUserName, yc.secret)
+}
+
 func (yc *ytsaurusClient) Fetch(ctx context.Context) error {
 	return resources.Fetch(ctx, []resources.Fetchable{
 		yc.secret,
@@ -88,6 +92,29 @@ func (yc *ytsaurusClient) createInitUserScript() string {
 	return initJob + "\n" + strings.Join(createUserCommand(consts.YtsaurusOperatorUserName, "", token, true), "\n")
 }
 
+// GetYtsaurusClientSecretName returns the name of the secret with the token of the operator's robot user.
+func GetYtsaurusClientSecretName() string {
+	l := labeller.Labeller{ComponentLabel: consts.YTComponentLabelClient}
+	return l.GetSecretName()
+}
+
+// NewYtClient creates a client of the cluster, which is used by the operator itself.
+func NewYtClient(cfgen *ytconfig.Generator, token string) (yt.Client, error) {
+	timeout := time.Second * 10
+	proxy, ok := os.LookupEnv("YTOP_PROXY")
+	disableProxyDiscovery := true
+	if !ok {
+		proxy = cfgen.GetHTTPProxiesAddress(consts.DefaultHTTPProxyRole)
+		disableProxyDiscovery = false
+	}
+	return ythttp.NewClient(&yt.Config{
+		Proxy:                 proxy,
+		Token:                 token,
+		LightRequestTimeout:   &timeout,
+		DisableProxyDiscovery: disableProxyDiscovery,
+	})
+}
+
 type TabletCellBundleHealth struct {
 	Name   string `yson:",value" json:"name"`
 	Health string `yson:"health,attr" json:"health"`
@@ -522,19 +549,7 @@ func (yc *ytsaurusClient) doSync(ctx context.Context, dry bool) (ComponentStatus
 
 	if yc.ytClient == nil {
 		token, _ := yc.secret.GetValue(consts.TokenSecretKey)
-		timeout := time.Second * 10
-		proxy, ok := os.LookupEnv("YTOP_PROXY")
-		disableProxyDiscovery := true
-		if !ok {
-			proxy = yc.cfgen.GetHTTPProxiesAddress(consts.DefaultHTTPProxyRole)
-			disableProxyDiscovery = false
-		}
-		yc.ytClient, err = ythttp.NewClient(&yt.Config{
-			Proxy:                 proxy,
-			Token:                 token,
-			LightRequestTimeout:   &timeout,
-			DisableProxyDiscovery: disableProxyDiscovery,
-		})
+		yc.ytClient, err = NewYtClient(yc.cfgen, token)
 
 		if err != nil {
 			return WaitingStatus(SyncStatusPending, "ytClient init"), err
@@ -10,6 +10,12 @@ const (
 	MasterRPCPort        = 9010
 	MasterMonitoringPort = 10010
 
+	MasterCacheRPCPort        = 9018
+	MasterCacheMonitoringPort = 10018
+
+	TimestampProviderRPCPort        = 9015
+	TimestampProviderMonitoringPort = 10015
+
 	SchedulerRPCPort        = 9011
 	SchedulerMonitoringPort = 10011
 
@@ -45,8 +51,20 @@ const (
 	QueryTrackerRPCPort        = 9028
 	QueryTrackerMonitoringPort = 10028
 
+	QueueAgentRPCPort        = 9030
+	QueueAgentMonitoringPort = 10030
+
+	TabletBalancerRPCPort        = 9031
+	TabletBalancerMonitoringPort = 10031
+
+	CellBalancerRPCPort        = 9032
+	CellBalancerMonitoringPort = 10032
+
+	ReplicatedTableTrackerRPCPort        = 9033
+	ReplicatedTableTrackerMonitoringPort = 10033
+
 	YQLAgentRPCPort        = 9019
-	YQLAgentMonitoringPort = 10029
+	YQLAgentMonitoringPort = 10019
 
 	UIHTTPPort = 80
 )
@@ -4,6 +4,10 @@ const (
 	ConfigMountPoint           = "/config"
 	HTTPSSecretMountPoint      = "/config/https_secret"
 	RPCSecretMountPoint        = "/config/rpc_secret"
+	BusSecretMountPoint        = "/config/bus_secret"
+	BusCASecretMountPoint      = "/config/bus_ca_secret"
+	OidcSecretMountPoint       = "/config/oidc_secret"
+	LdapSecretMountPoint       = "/config/ldap_secret"
 	UIClustersConfigMountPoint = "/opt/app"
 	UICustomConfigMountPoint   = "/opt/app/dist/server/configs/custom"
 	UISecretsMountPoint        = "/opt/app/secrets"
@@ -25,13 +29,21 @@ const (
 	UIClusterConfigFileName = "clusters-config.json"
 	UISecretFileName        = "yt-interface-secret.json"
 	TokenSecretKey          = "YT_TOKEN"
+	CACertSecretKey         = "ca.crt"
 )
 
 const (
 	ConfigVolumeName      = "config"
 	HTTPSSecretVolumeName = "https-secret"
 	RPCSecretVolumeName   = "rpc-secret"
+	BusSecretVolumeName   = "bus-secret"
+	BusCASecretVolumeName = "bus-ca-secret"
+	OidcSecretVolumeName  = "oidc-secret"
+	LdapSecretVolumeName  = "ldap-secret"
 	InitScriptVolumeName  = "init-script"
 	UIVaultVolumeName     = "vault"
 	UISecretsVolumeName   = "secrets"
 )
+
+// BusSecretNameSuffix follows the stateful set name in the names of the bus certificate secrets.
+const BusSecretNameSuffix = "-bus-tls"
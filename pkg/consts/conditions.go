@@ -15,4 +15,15 @@ const ConditionOpArchivePreparedForUpdating = "OpArchivePreparedForUpdating"
 const ConditionNotNecessaryToUpdateOpArchive = "NotNecessaryToUpdateOpArchive"
 const ConditionQTStateUpdated = "QTStateUpdated"
 const ConditionQTStatePreparedForUpdating = "QTStatePreparedForUpdating"
+const ConditionQAStateUpdated = "QAStateUpdated"
+const ConditionQAStatePreparedForUpdating = "QAStatePreparedForUpdating"
 const ConditionSafeModeDisabled = "SafeModeDisabled"
+
+const ConditionClusterConnectionInSync = "ClusterConnectionInSync"
+const ConditionMediaInSync = "MediaInSync"
+const ConditionPoolTreesInSync = "PoolTreesInSync"
+const ConditionTabletCellBundlesInSync = "TabletCellBundlesInSync"
+const ConditionRemoteClustersInSync = "RemoteClustersInSync"
+
+const ConditionSynced = "Synced"
+const ConditionReady = "Ready"
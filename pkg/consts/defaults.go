@@ -1,7 +1,6 @@
 package consts
 
 const DefaultAdminLogin = "admin"
-const DefaultAdminPassword = "password"
 
 const AdminLoginSecret = "login"
 const AdminPasswordSecret = "password"
@@ -10,6 +9,9 @@ const AdminTokenSecret = "token"
 const UIUserName = "robot-ui"
 const StrawberryControllerUserName = "robot-strawberry-controller"
 const YqlUserName = "yql_agent"
+const TabletBalancerUserName = "tablet_balancer"
+const CellBalancerUserName = "cell_balancer"
+const QueueAgentUserName = "queue_agent"
 const YtsaurusOperatorUserName = "robot-yt-k8s-operator"
 
 const StartUID = 19500
@@ -17,3 +19,5 @@ const StartUID = 19500
 const DefaultHTTPProxyRole = "default"
 const DefaultName = "default"
 const DefaultMedium = "default"
+const DefaultPoolTree = "default"
+const DefaultPool = "research"
@@ -3,18 +3,50 @@ package consts
 const YTComponentLabelName = "yt_component"
 const YTMetricsLabelName = "yt_metrics"
 
+// RemoteClusterLabelName is set on the pods of remote nodes to the name of the cluster they are attached to,
+// so the network policies of the cluster let them in.
+const RemoteClusterLabelName = "ytsaurus.tech/remote-cluster"
+
+// TLSSecretHashAnnotationPrefix is followed by the secret volume name in pod template annotations.
+const TLSSecretHashAnnotationPrefix = "ytsaurus.tech/tls-hash-"
+
+// BusEncryptionModeAnnotation is set on the pod templates to the bus encryption mode of the component configs,
+// so the pods are rolled one by one when the mode is switched.
+const BusEncryptionModeAnnotation = "ytsaurus.tech/bus-encryption-mode"
+
+// RobotTokenIssuedAtAnnotation is set on the robot token secrets and on the pod templates
+// of their consumers, so the pods are rolled when the token is rotated.
+const RobotTokenIssuedAtAnnotation = "ytsaurus.tech/robot-token-issued-at"
+
+// RobotTokenPreviousHashAnnotation keeps the hash of the rotated token in its secret until the token is revoked.
+const RobotTokenPreviousHashAnnotation = "ytsaurus.tech/robot-token-previous-sha256"
+
+// CypressObjectFinalizer is set on the resources which are synced to objects in Cypress,
+// so the objects are removed when the resources are deleted.
+const CypressObjectFinalizer = "ytsaurus.tech/cypress-object"
+
 const (
-	YTComponentLabelDiscovery       string = "yt-discovery"
-	YTComponentLabelMaster          string = "yt-master"
-	YTComponentLabelScheduler       string = "yt-scheduler"
-	YTComponentLabelControllerAgent string = "yt-controller-agent"
-	YTComponentLabelDataNode        string = "yt-data-node"
-	YTComponentLabelExecNode        string = "yt-exec-node"
-	YTComponentLabelTabletNode      string = "yt-tablet-node"
-	YTComponentLabelHTTPProxy       string = "yt-http-proxy"
-	YTComponentLabelRPCProxy        string = "yt-rpc-proxy"
-	YTComponentLabelTCPProxy        string = "yt-tcp-proxy"
-	YTComponentLabelUI              string = "yt-ui"
-	YTComponentLabelYqlAgent        string = "yt-yql-agent"
-	YTComponentLabelClient          string = "yt-client"
+	YTComponentLabelDiscovery              string = "yt-discovery"
+	YTComponentLabelMaster                 string = "yt-master"
+	YTComponentLabelMasterCache            string = "yt-master-cache"
+	YTComponentLabelTimestampProvider      string = "yt-timestamp-provider"
+	YTComponentLabelScheduler              string = "yt-scheduler"
+	YTComponentLabelControllerAgent        string = "yt-controller-agent"
+	YTComponentLabelDataNode               string = "yt-data-node"
+	YTComponentLabelExecNode               string = "yt-exec-node"
+	YTComponentLabelRemoteExecNode         string = "yt-remote-exec-node"
+	YTComponentLabelRemoteDataNode         string = "yt-remote-data-node"
+	YTComponentLabelTabletNode             string = "yt-tablet-node"
+	YTComponentLabelTabletBalancer         string = "yt-tablet-balancer"
+	YTComponentLabelCellBalancer           string = "yt-cell-balancer"
+	YTComponentLabelReplicatedTableTracker string = "yt-replicated-table-tracker"
+	YTComponentLabelHTTPProxy              string = "yt-http-proxy"
+	YTComponentLabelRPCProxy               string = "yt-rpc-proxy"
+	YTComponentLabelTCPProxy               string = "yt-tcp-proxy"
+	YTComponentLabelUI                     string = "yt-ui"
+	YTComponentLabelYqlAgent               string = "yt-yql-agent"
+	YTComponentLabelQueueAgent             string = "yt-queue-agent"
+	YTComponentLabelClient                 string = "yt-client"
+	YTComponentLabelCypressState           string = "yt-cypress-state"
+	YTComponentLabelRobotTokens            string = "yt-robot-tokens"
 )
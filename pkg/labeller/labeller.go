@@ -69,12 +69,18 @@ func (l *Labeller) GetListOptions() []client.ListOption {
 }
 
 func (l *Labeller) GetMetaLabelMap() map[string]string {
+	labels := GetClusterLabelMap(l.ObjectMeta.Name)
+	labels["app.kubernetes.io/component"] = l.ComponentLabel
+	labels["app.kubernetes.io/managed-by"] = "Ytsaurus-k8s-operator"
+	labels[consts.YTComponentLabelName] = l.GetYTLabelValue()
+	return labels
+}
+
+// GetClusterLabelMap returns the labels shared by all the pods of the cluster.
+func GetClusterLabelMap(clusterName string) map[string]string {
 	return map[string]string{
-		"app.kubernetes.io/name":       "Ytsaurus",
-		"app.kubernetes.io/instance":   l.ObjectMeta.Name,
-		"app.kubernetes.io/component":  l.ComponentLabel,
-		"app.kubernetes.io/managed-by": "Ytsaurus-k8s-operator",
-		consts.YTComponentLabelName:    l.GetYTLabelValue(),
+		"app.kubernetes.io/name":     "Ytsaurus",
+		"app.kubernetes.io/instance": clusterName,
 	}
 }
 
@@ -0,0 +1,102 @@
+package resources
+
+import (
+	"context"
+
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	labeller2 "github.com/ytsaurus/yt-k8s-operator/pkg/labeller"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CertificateGVK is the cert-manager certificate kind. Certificates are handled as unstructured objects,
+// so cert-manager is only required when certificates are actually requested.
+var CertificateGVK = schema.GroupVersionKind{
+	Group:   "cert-manager.io",
+	Version: "v1",
+	Kind:    "Certificate",
+}
+
+// Certificate asks cert-manager to issue a kubernetes.io/tls secret with the same name.
+type Certificate struct {
+	name     string
+	spec     *ytv1.CertificateSpec
+	dnsNames []string
+	labeller *labeller2.Labeller
+	apiProxy apiproxy.APIProxy
+
+	oldObject unstructured.Unstructured
+	newObject unstructured.Unstructured
+}
+
+func NewCertificate(
+	secretName string,
+	spec *ytv1.CertificateSpec,
+	dnsNames []string,
+	labeller *labeller2.Labeller,
+	apiProxy apiproxy.APIProxy) *Certificate {
+	c := &Certificate{
+		name:     secretName,
+		spec:     spec,
+		dnsNames: append(dnsNames, spec.ExtraDNSNames...),
+		labeller: labeller,
+		apiProxy: apiProxy,
+	}
+	c.oldObject.SetGroupVersionKind(CertificateGVK)
+	return c
+}
+
+func (c *Certificate) OldObject() client.Object {
+	return &c.oldObject
+}
+
+func (c *Certificate) Name() string {
+	return c.name
+}
+
+func (c *Certificate) NeedSync() bool {
+	_ = c.Build()
+	return !Exists(c) || !isSubset(c.newObject.Object["spec"], c.oldObject.Object["spec"])
+}
+
+func (c *Certificate) Sync(ctx context.Context) error {
+	_ = c.Build()
+	return c.apiProxy.SyncObject(ctx, &c.oldObject, &c.newObject)
+}
+
+func (c *Certificate) Build() *unstructured.Unstructured {
+	meta := c.labeller.GetObjectMeta(c.name)
+	c.newObject.Object = map[string]interface{}{}
+	c.newObject.SetGroupVersionKind(CertificateGVK)
+	c.newObject.SetName(meta.Name)
+	c.newObject.SetNamespace(meta.Namespace)
+	c.newObject.SetLabels(meta.Labels)
+
+	var dnsNames []interface{}
+	for _, name := range c.dnsNames {
+		dnsNames = append(dnsNames, name)
+	}
+
+	issuerRef := map[string]interface{}{
+		"name": c.spec.IssuerRef.Name,
+	}
+	if c.spec.IssuerRef.Kind != "" {
+		issuerRef["kind"] = c.spec.IssuerRef.Kind
+	}
+	if c.spec.IssuerRef.Group != "" {
+		issuerRef["group"] = c.spec.IssuerRef.Group
+	}
+
+	c.newObject.Object["spec"] = map[string]interface{}{
+		"secretName": c.name,
+		"dnsNames":   dnsNames,
+		"issuerRef":  issuerRef,
+	}
+	return &c.newObject
+}
+
+func (c *Certificate) Fetch(ctx context.Context) error {
+	return c.apiProxy.FetchObject(ctx, c.name, &c.oldObject)
+}
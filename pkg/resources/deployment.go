@@ -46,6 +46,12 @@ func (d *Deployment) Sync(ctx context.Context) error {
 
 func (d *Deployment) Build() *appsv1.Deployment {
 	if !d.built {
+		// Components add their own annotations, so the spec map must not be shared.
+		annotations := make(map[string]string, len(d.ytsaurus.GetResource().Spec.ExtraPodAnnotations))
+		for key, value := range d.ytsaurus.GetResource().Spec.ExtraPodAnnotations {
+			annotations[key] = value
+		}
+
 		d.newObject.ObjectMeta = d.labeller.GetObjectMeta(d.name)
 		d.newObject.Spec = appsv1.DeploymentSpec{
 			Selector: &metav1.LabelSelector{
@@ -54,7 +60,7 @@ func (d *Deployment) Build() *appsv1.Deployment {
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels:      d.labeller.GetMetaLabelMap(),
-					Annotations: d.ytsaurus.GetResource().Spec.ExtraPodAnnotations,
+					Annotations: annotations,
 				},
 				Spec: corev1.PodSpec{
 					ImagePullSecrets: d.ytsaurus.GetResource().Spec.ImagePullSecrets,
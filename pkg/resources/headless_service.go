@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
 	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
 	labeller2 "github.com/ytsaurus/yt-k8s-operator/pkg/labeller"
 	corev1 "k8s.io/api/core/v1"
@@ -10,19 +11,21 @@ import (
 )
 
 type HeadlessService struct {
-	name     string
-	labeller *labeller2.Labeller
-	apiProxy apiproxy.APIProxy
+	name       string
+	labeller   *labeller2.Labeller
+	apiProxy   apiproxy.APIProxy
+	commonSpec *ytv1.CommonSpec
 
 	oldObject corev1.Service
 	newObject corev1.Service
 }
 
-func NewHeadlessService(name string, labeller *labeller2.Labeller, apiProxy apiproxy.APIProxy) *HeadlessService {
+func NewHeadlessService(name string, labeller *labeller2.Labeller, apiProxy apiproxy.APIProxy, commonSpec *ytv1.CommonSpec) *HeadlessService {
 	return &HeadlessService{
-		name:     name,
-		labeller: labeller,
-		apiProxy: apiProxy,
+		name:       name,
+		labeller:   labeller,
+		apiProxy:   apiProxy,
+		commonSpec: commonSpec,
 	}
 }
 
@@ -44,6 +47,7 @@ func (s *HeadlessService) Build() *corev1.Service {
 		ClusterIP: "None",
 		Selector:  s.labeller.GetSelectorLabelMap(),
 	}
+	setIPFamilies(&s.newObject.Spec, s.commonSpec)
 
 	return &s.newObject
 }
@@ -0,0 +1,70 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	labeller2 "github.com/ytsaurus/yt-k8s-operator/pkg/labeller"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// HTTPRouteGVK is the Gateway API route kind. Routes are handled as unstructured objects,
+// so the Gateway API CRDs are only required when routes are actually requested.
+var HTTPRouteGVK = schema.GroupVersionKind{
+	Group:   "gateway.networking.k8s.io",
+	Version: "v1",
+	Kind:    "HTTPRoute",
+}
+
+type HTTPRoute struct {
+	name     string
+	labeller *labeller2.Labeller
+	apiProxy apiproxy.APIProxy
+
+	oldObject unstructured.Unstructured
+	newObject unstructured.Unstructured
+}
+
+func NewHTTPRoute(name string, labeller *labeller2.Labeller, apiProxy apiproxy.APIProxy) *HTTPRoute {
+	r := &HTTPRoute{
+		name:     name,
+		labeller: labeller,
+		apiProxy: apiProxy,
+	}
+	r.oldObject.SetGroupVersionKind(HTTPRouteGVK)
+	return r
+}
+
+func (r *HTTPRoute) OldObject() client.Object {
+	return &r.oldObject
+}
+
+func (r *HTTPRoute) Name() string {
+	return r.name
+}
+
+// NeedSync compares only the fields set by the operator, since the API server fills in defaults.
+func (r *HTTPRoute) NeedSync() bool {
+	return !Exists(r) || !isSubset(r.newObject.Object["spec"], r.oldObject.Object["spec"])
+}
+
+func (r *HTTPRoute) Sync(ctx context.Context) error {
+	return r.apiProxy.SyncObject(ctx, &r.oldObject, &r.newObject)
+}
+
+// Build returns the route with an empty spec to be filled by the caller.
+func (r *HTTPRoute) Build() *unstructured.Unstructured {
+	meta := r.labeller.GetObjectMeta(r.name)
+	r.newObject.Object = map[string]interface{}{}
+	r.newObject.SetGroupVersionKind(HTTPRouteGVK)
+	r.newObject.SetName(meta.Name)
+	r.newObject.SetNamespace(meta.Namespace)
+	r.newObject.SetLabels(meta.Labels)
+	return &r.newObject
+}
+
+func (r *HTTPRoute) Fetch(ctx context.Context) error {
+	return r.apiProxy.FetchObject(ctx, r.name, &r.oldObject)
+}
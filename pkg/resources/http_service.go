@@ -13,24 +13,26 @@ import (
 )
 
 type HTTPService struct {
-	name      string
-	transport *ytv1.HTTPTransportSpec
-	labeller  *labeller2.Labeller
-	apiProxy  apiproxy.APIProxy
+	name       string
+	transport  *ytv1.HTTPTransportSpec
+	labeller   *labeller2.Labeller
+	apiProxy   apiproxy.APIProxy
+	commonSpec *ytv1.CommonSpec
 
 	oldObject corev1.Service
 	newObject corev1.Service
 }
 
-func NewHTTPService(name string, transport *ytv1.HTTPTransportSpec, labeller *labeller2.Labeller, apiProxy apiproxy.APIProxy) *HTTPService {
+func NewHTTPService(name string, transport *ytv1.HTTPTransportSpec, labeller *labeller2.Labeller, apiProxy apiproxy.APIProxy, commonSpec *ytv1.CommonSpec) *HTTPService {
 	if transport == nil {
 		transport = &ytv1.HTTPTransportSpec{}
 	}
 	return &HTTPService{
-		name:      name,
-		transport: transport,
-		labeller:  labeller,
-		apiProxy:  apiProxy,
+		name:       name,
+		transport:  transport,
+		labeller:   labeller,
+		apiProxy:   apiProxy,
+		commonSpec: commonSpec,
 	}
 }
 
@@ -51,6 +53,7 @@ func (s *HTTPService) Build() *corev1.Service {
 	s.newObject.Spec = corev1.ServiceSpec{
 		Selector: s.labeller.GetSelectorLabelMap(),
 	}
+	setIPFamilies(&s.newObject.Spec, s.commonSpec)
 
 	if !s.transport.DisableHTTP {
 		s.newObject.Spec.Ports = []corev1.ServicePort{
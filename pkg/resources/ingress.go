@@ -0,0 +1,63 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	labeller2 "github.com/ytsaurus/yt-k8s-operator/pkg/labeller"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type Ingress struct {
+	name     string
+	labeller *labeller2.Labeller
+	apiProxy apiproxy.APIProxy
+
+	oldObject networkingv1.Ingress
+	newObject networkingv1.Ingress
+}
+
+func NewIngress(name string, labeller *labeller2.Labeller, apiProxy apiproxy.APIProxy) *Ingress {
+	return &Ingress{
+		name:     name,
+		labeller: labeller,
+		apiProxy: apiProxy,
+	}
+}
+
+func (i *Ingress) OldObject() client.Object {
+	return &i.oldObject
+}
+
+func (i *Ingress) Name() string {
+	return i.name
+}
+
+// NeedSync ignores annotations that are not set by the operator, e.g. ones added by ingress controllers.
+func (i *Ingress) NeedSync() bool {
+	if !Exists(i) || !equality.Semantic.DeepEqual(i.oldObject.Spec, i.newObject.Spec) {
+		return true
+	}
+	for key, value := range i.newObject.Annotations {
+		if oldValue, ok := i.oldObject.Annotations[key]; !ok || oldValue != value {
+			return true
+		}
+	}
+	return false
+}
+
+func (i *Ingress) Sync(ctx context.Context) error {
+	return i.apiProxy.SyncObject(ctx, &i.oldObject, &i.newObject)
+}
+
+func (i *Ingress) Build() *networkingv1.Ingress {
+	i.newObject.ObjectMeta = i.labeller.GetObjectMeta(i.name)
+	i.newObject.Spec = networkingv1.IngressSpec{}
+	return &i.newObject
+}
+
+func (i *Ingress) Fetch(ctx context.Context) error {
+	return i.apiProxy.FetchObject(ctx, i.name, &i.oldObject)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
 	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
 	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
 	labeller2 "github.com/ytsaurus/yt-k8s-operator/pkg/labeller"
@@ -14,19 +15,21 @@ import (
 )
 
 type MonitoringService struct {
-	name     string
-	labeller *labeller2.Labeller
-	apiProxy apiproxy.APIProxy
+	name       string
+	labeller   *labeller2.Labeller
+	apiProxy   apiproxy.APIProxy
+	commonSpec *ytv1.CommonSpec
 
 	oldObject corev1.Service
 	newObject corev1.Service
 }
 
-func NewMonitoringService(labeller *labeller2.Labeller, apiProxy apiproxy.APIProxy) *MonitoringService {
+func NewMonitoringService(labeller *labeller2.Labeller, apiProxy apiproxy.APIProxy, commonSpec *ytv1.CommonSpec) *MonitoringService {
 	return &MonitoringService{
-		name:     fmt.Sprintf("%s-monitoring", labeller.ComponentLabel),
-		labeller: labeller,
-		apiProxy: apiProxy,
+		name:       fmt.Sprintf("%s-monitoring", labeller.ComponentLabel),
+		labeller:   labeller,
+		apiProxy:   apiProxy,
+		commonSpec: commonSpec,
 	}
 }
 
@@ -66,6 +69,7 @@ func (s *MonitoringService) Build() *corev1.Service {
 			},
 		},
 	}
+	setIPFamilies(&s.newObject.Spec, s.commonSpec)
 
 	return &s.newObject
 }
@@ -0,0 +1,61 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	labeller2 "github.com/ytsaurus/yt-k8s-operator/pkg/labeller"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type NetworkPolicy struct {
+	name     string
+	labeller *labeller2.Labeller
+	apiProxy apiproxy.APIProxy
+
+	oldObject networkingv1.NetworkPolicy
+	newObject networkingv1.NetworkPolicy
+}
+
+func NewNetworkPolicy(labeller *labeller2.Labeller, apiProxy apiproxy.APIProxy) *NetworkPolicy {
+	return &NetworkPolicy{
+		name:     fmt.Sprintf("%s-network-policy", labeller.ComponentLabel),
+		labeller: labeller,
+		apiProxy: apiProxy,
+	}
+}
+
+func (p *NetworkPolicy) OldObject() client.Object {
+	return &p.oldObject
+}
+
+func (p *NetworkPolicy) Name() string {
+	return p.name
+}
+
+func (p *NetworkPolicy) NeedSync() bool {
+	return !Exists(p) || !equality.Semantic.DeepEqual(p.oldObject.Spec, p.newObject.Spec)
+}
+
+func (p *NetworkPolicy) Sync(ctx context.Context) error {
+	return p.apiProxy.SyncObject(ctx, &p.oldObject, &p.newObject)
+}
+
+func (p *NetworkPolicy) Build() *networkingv1.NetworkPolicy {
+	p.newObject.ObjectMeta = p.labeller.GetObjectMeta(p.name)
+	p.newObject.Spec = networkingv1.NetworkPolicySpec{
+		PodSelector: metav1.LabelSelector{
+			MatchLabels: p.labeller.GetSelectorLabelMap(),
+		},
+		PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+	}
+	return &p.newObject
+}
+
+func (p *NetworkPolicy) Fetch(ctx context.Context) error {
+	return p.apiProxy.FetchObject(ctx, p.name, &p.oldObject)
+}
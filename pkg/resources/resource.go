@@ -2,6 +2,10 @@ package resources
 
 import (
 	"context"
+	"reflect"
+
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -42,3 +46,54 @@ func Sync(ctx context.Context, objects []Syncable) error {
 	}
 	return nil
 }
+
+// setIPFamilies configures the IP families of a service according to the common spec.
+// The families are left to the cluster defaults if the policy is not set.
+func setIPFamilies(spec *corev1.ServiceSpec, commonSpec *ytv1.CommonSpec) {
+	if commonSpec.IPFamilyPolicy == nil {
+		return
+	}
+
+	primary, secondary := corev1.IPv4Protocol, corev1.IPv6Protocol
+	if commonSpec.UseIPv6 {
+		primary, secondary = secondary, primary
+	}
+
+	policy := *commonSpec.IPFamilyPolicy
+	spec.IPFamilyPolicy = &policy
+	spec.IPFamilies = []corev1.IPFamily{primary}
+	if ytv1.IsDualStack(commonSpec) {
+		spec.IPFamilies = append(spec.IPFamilies, secondary)
+	}
+}
+
+// isSubset checks that all the fields of the expected unstructured value are present in the actual one.
+// It is used to compare objects of foreign kinds, whose defaulted fields are unknown to the operator.
+func isSubset(expected, actual interface{}) bool {
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, value := range e {
+			if !isSubset(value, a[key]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok || len(a) != len(e) {
+			return false
+		}
+		for i := range e {
+			if !isSubset(e[i], a[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(expected, actual)
+	}
+}
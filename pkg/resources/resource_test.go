@@ -0,0 +1,37 @@
+package resources
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestSetIPFamilies(t *testing.T) {
+	g := NewWithT(t)
+
+	singleStack := corev1.IPFamilyPolicySingleStack
+	preferDualStack := corev1.IPFamilyPolicyPreferDualStack
+	requireDualStack := corev1.IPFamilyPolicyRequireDualStack
+
+	for _, tc := range []struct {
+		name     string
+		useIPv6  bool
+		policy   *corev1.IPFamilyPolicyType
+		families []corev1.IPFamily
+	}{
+		{"cluster defaults", false, nil, nil},
+		{"cluster defaults with IPv6", true, nil, nil},
+		{"single-stack IPv4", false, &singleStack, []corev1.IPFamily{corev1.IPv4Protocol}},
+		{"single-stack IPv6", true, &singleStack, []corev1.IPFamily{corev1.IPv6Protocol}},
+		{"dual-stack with IPv4 first", false, &preferDualStack, []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol}},
+		{"dual-stack with IPv6 first", true, &requireDualStack, []corev1.IPFamily{corev1.IPv6Protocol, corev1.IPv4Protocol}},
+	} {
+		var spec corev1.ServiceSpec
+		setIPFamilies(&spec, &ytv1.CommonSpec{UseIPv6: tc.useIPv6, IPFamilyPolicy: tc.policy})
+
+		g.Expect(spec.IPFamilyPolicy).Should(Equal(tc.policy), tc.name)
+		g.Expect(spec.IPFamilies).Should(Equal(tc.families), tc.name)
+	}
+}
@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
 	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
 	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
 	labeller2 "github.com/ytsaurus/yt-k8s-operator/pkg/labeller"
@@ -12,19 +13,21 @@ import (
 )
 
 type RPCService struct {
-	name     string
-	labeller *labeller2.Labeller
-	apiProxy apiproxy.APIProxy
+	name       string
+	labeller   *labeller2.Labeller
+	apiProxy   apiproxy.APIProxy
+	commonSpec *ytv1.CommonSpec
 
 	oldObject corev1.Service
 	newObject corev1.Service
 }
 
-func NewRPCService(name string, labeller *labeller2.Labeller, apiProxy apiproxy.APIProxy) *RPCService {
+func NewRPCService(name string, labeller *labeller2.Labeller, apiProxy apiproxy.APIProxy, commonSpec *ytv1.CommonSpec) *RPCService {
 	return &RPCService{
-		name:     name,
-		labeller: labeller,
-		apiProxy: apiProxy,
+		name:       name,
+		labeller:   labeller,
+		apiProxy:   apiProxy,
+		commonSpec: commonSpec,
 	}
 }
 
@@ -56,6 +59,7 @@ func (s *RPCService) Build() *corev1.Service {
 			},
 		},
 	}
+	setIPFamilies(&s.newObject.Spec, s.commonSpec)
 
 	return &s.newObject
 }
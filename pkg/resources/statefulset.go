@@ -2,6 +2,8 @@ package resources
 
 import (
 	"context"
+
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
 	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
 	labeller2 "github.com/ytsaurus/yt-k8s-operator/pkg/labeller"
 	appsv1 "k8s.io/api/apps/v1"
@@ -12,9 +14,10 @@ import (
 )
 
 type StatefulSet struct {
-	name     string
-	labeller *labeller2.Labeller
-	ytsaurus *apiproxy.Ytsaurus
+	name       string
+	labeller   *labeller2.Labeller
+	apiProxy   apiproxy.APIProxy
+	commonSpec *ytv1.CommonSpec
 
 	oldObject appsv1.StatefulSet
 	newObject appsv1.StatefulSet
@@ -24,11 +27,13 @@ type StatefulSet struct {
 func NewStatefulSet(
 	name string,
 	labeller *labeller2.Labeller,
-	ytsaurus *apiproxy.Ytsaurus) *StatefulSet {
+	apiProxy apiproxy.APIProxy,
+	commonSpec *ytv1.CommonSpec) *StatefulSet {
 	return &StatefulSet{
-		name:     name,
-		labeller: labeller,
-		ytsaurus: ytsaurus,
+		name:       name,
+		labeller:   labeller,
+		apiProxy:   apiProxy,
+		commonSpec: commonSpec,
 	}
 }
 
@@ -41,11 +46,17 @@ func (s *StatefulSet) Name() string {
 }
 
 func (s *StatefulSet) Sync(ctx context.Context) error {
-	return s.ytsaurus.APIProxy().SyncObject(ctx, &s.oldObject, &s.newObject)
+	return s.apiProxy.SyncObject(ctx, &s.oldObject, &s.newObject)
 }
 
 func (s *StatefulSet) Build() *appsv1.StatefulSet {
 	if !s.built {
+		// Components add their own annotations, so the spec map must not be shared.
+		annotations := make(map[string]string, len(s.commonSpec.ExtraPodAnnotations))
+		for key, value := range s.commonSpec.ExtraPodAnnotations {
+			annotations[key] = value
+		}
+
 		s.newObject.ObjectMeta = s.labeller.GetObjectMeta(s.name)
 		s.newObject.Spec = appsv1.StatefulSetSpec{
 			PodManagementPolicy: appsv1.ParallelPodManagement,
@@ -55,7 +66,7 @@ func (s *StatefulSet) Build() *appsv1.StatefulSet {
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels:      s.labeller.GetMetaLabelMap(),
-					Annotations: s.ytsaurus.GetResource().Spec.ExtraPodAnnotations,
+					Annotations: annotations,
 				},
 			},
 		}
@@ -68,7 +79,7 @@ func (s *StatefulSet) Build() *appsv1.StatefulSet {
 func (s *StatefulSet) ArePodsReady(ctx context.Context) bool {
 	logger := log.FromContext(ctx)
 	podList := &corev1.PodList{}
-	err := s.ytsaurus.APIProxy().ListObjects(ctx, podList, s.labeller.GetListOptions()...)
+	err := s.apiProxy.ListObjects(ctx, podList, s.labeller.GetListOptions()...)
 	if err != nil {
 		logger.Error(err, "unable to list pods for component", "component", s.labeller.ComponentName)
 		return false
@@ -95,5 +106,5 @@ func (s *StatefulSet) NeedSync(replicas int32) bool {
 }
 
 func (s *StatefulSet) Fetch(ctx context.Context) error {
-	return s.ytsaurus.APIProxy().FetchObject(ctx, s.name, &s.oldObject)
+	return s.apiProxy.FetchObject(ctx, s.name, &s.oldObject)
 }
@@ -46,6 +46,11 @@ func (s *StringSecret) GetValue(key string) (string, bool) {
 	return string(v), ok
 }
 
+// GetAnnotation returns the annotation of the existing secret.
+func (s *StringSecret) GetAnnotation(key string) string {
+	return s.oldObject.Annotations[key]
+}
+
 func (s *StringSecret) GetEnvSource() corev1.EnvFromSource {
 	return corev1.EnvFromSource{
 		SecretRef: &corev1.SecretEnvSource{
@@ -3,6 +3,8 @@ package resources
 import (
 	"context"
 	"fmt"
+
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
 	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
 	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
 	labeller2 "github.com/ytsaurus/yt-k8s-operator/pkg/labeller"
@@ -14,18 +16,20 @@ import (
 type TCPService struct {
 	name string
 
-	labeller *labeller2.Labeller
-	apiProxy apiproxy.APIProxy
+	labeller   *labeller2.Labeller
+	apiProxy   apiproxy.APIProxy
+	commonSpec *ytv1.CommonSpec
 
 	oldObject corev1.Service
 	newObject corev1.Service
 }
 
-func NewTCPService(name string, labeller *labeller2.Labeller, apiProxy apiproxy.APIProxy) *TCPService {
+func NewTCPService(name string, labeller *labeller2.Labeller, apiProxy apiproxy.APIProxy, commonSpec *ytv1.CommonSpec) *TCPService {
 	return &TCPService{
-		name:     name,
-		labeller: labeller,
-		apiProxy: apiProxy,
+		name:       name,
+		labeller:   labeller,
+		apiProxy:   apiProxy,
+		commonSpec: commonSpec,
 	}
 }
 
@@ -61,6 +65,7 @@ func (s *TCPService) Build() *corev1.Service {
 		Selector: s.labeller.GetSelectorLabelMap(),
 		Ports:    ports,
 	}
+	setIPFamilies(&s.newObject.Spec, s.commonSpec)
 
 	return &s.newObject
 }
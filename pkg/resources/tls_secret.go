@@ -1,7 +1,15 @@
 package resources
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+
+	"github.com/ytsaurus/yt-k8s-operator/pkg/apiproxy"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
 	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // TLSSecret represents mounted kubernetes.io/tls secret
@@ -10,16 +18,28 @@ type TLSSecret struct {
 	SecretName string
 	VolumeName string
 	MountPath  string
+
+	apiProxy  apiproxy.APIProxy
+	oldObject corev1.Secret
 }
 
-func NewTLSSecret(secretName string, volumeName string, mountPath string) *TLSSecret {
+func NewTLSSecret(secretName string, volumeName string, mountPath string, apiProxy apiproxy.APIProxy) *TLSSecret {
 	return &TLSSecret{
 		SecretName: secretName,
 		VolumeName: volumeName,
 		MountPath:  mountPath,
+		apiProxy:   apiProxy,
 	}
 }
 
+func (t *TLSSecret) OldObject() client.Object {
+	return &t.oldObject
+}
+
+func (t *TLSSecret) Fetch(ctx context.Context) error {
+	return t.apiProxy.FetchObject(ctx, t.SecretName, &t.oldObject)
+}
+
 func (t *TLSSecret) AddVolume(podSpec *corev1.PodSpec) {
 	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
 		Name: t.VolumeName,
@@ -38,3 +58,50 @@ func (t *TLSSecret) AddVolumeMount(container *corev1.Container) {
 		ReadOnly:  true,
 	})
 }
+
+// Hash of the secret contents, empty if the secret does not exist yet.
+func (t *TLSSecret) Hash() string {
+	if !Exists(t) {
+		return ""
+	}
+
+	keys := make([]string, 0, len(t.oldObject.Data))
+	for key := range t.oldObject.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	hash := sha256.New()
+	for _, key := range keys {
+		hash.Write([]byte(key))
+		hash.Write([]byte{0})
+		hash.Write(t.oldObject.Data[key])
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func (t *TLSSecret) hashAnnotationName() string {
+	return consts.TLSSecretHashAnnotationPrefix + t.VolumeName
+}
+
+// AddHashAnnotation records the secret contents in the pod template,
+// so the pods are rolled by the stateful set controller when the secret is renewed.
+func (t *TLSSecret) AddHashAnnotation(template *corev1.PodTemplateSpec) {
+	hash := t.Hash()
+	if hash == "" {
+		return
+	}
+	if template.Annotations == nil {
+		template.Annotations = map[string]string{}
+	}
+	template.Annotations[t.hashAnnotationName()] = hash
+}
+
+// NeedHashUpdate checks if the pod template was built for different secret contents.
+// Templates without the hash were built before the hashes were recorded, their pods are not rolled
+// and the hash is recorded with the next change of the template.
+func (t *TLSSecret) NeedHashUpdate(template *corev1.PodTemplateSpec) bool {
+	hash, ok := template.Annotations[t.hashAnnotationName()]
+	return ok && hash != t.Hash()
+}
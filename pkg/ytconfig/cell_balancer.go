@@ -0,0 +1,48 @@
+package ytconfig
+
+import (
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+)
+
+const (
+	CellBalancerRootPath     = "//sys/cell_balancers"
+	BundleControllerRootPath = "//sys/bundle_controller/controller"
+)
+
+type ElectionManager struct {
+	LockPath string `yson:"lock_path"`
+}
+
+type BundleController struct {
+	Cluster  string `yson:"cluster"`
+	RootPath string `yson:"root_path"`
+}
+
+type CellBalancerServer struct {
+	CommonServer
+	ClusterUser      string           `yson:"cluster_user"`
+	ElectionManager  ElectionManager  `yson:"election_manager"`
+	BundleController BundleController `yson:"bundle_controller"`
+}
+
+func getCellBalancerLogging(spec ytv1.CellBalancersSpec) Logging {
+	return createLogging(
+		&spec.InstanceSpec,
+		"cell-balancer",
+		[]ytv1.TextLoggerSpec{defaultInfoLoggerSpec(), defaultStderrLoggerSpec()})
+}
+
+func getCellBalancerServerCarcass(spec ytv1.CellBalancersSpec) (CellBalancerServer, error) {
+	var c CellBalancerServer
+	c.RPCPort = consts.CellBalancerRPCPort
+	c.MonitoringPort = consts.CellBalancerMonitoringPort
+
+	c.ClusterUser = consts.CellBalancerUserName
+	c.ElectionManager.LockPath = CellBalancerRootPath + "/lock"
+	c.BundleController.RootPath = BundleControllerRootPath
+
+	c.Logging = getCellBalancerLogging(spec)
+
+	return c, nil
+}
@@ -1,7 +1,9 @@
 package ytconfig
 
 import (
+	crand "crypto/rand"
 	"fmt"
+	"math/big"
 	"math/rand"
 	"strings"
 
@@ -38,9 +40,23 @@ func generateCellID(cellTag int16) string {
 	return fmt.Sprintf("%s-%s-%s-%s", getGUIDPart(uuidBytes[12:]), getGUIDPart(uuidBytes[8:12]), getGUIDPart(uuidBytes[4:8]), getGUIDPart(uuidBytes[:4]))
 }
 
-func RandString(n int) string {
-	const letterBytes = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+const letterBytes = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// RandSecureString generates a string suitable for passwords and tokens.
+func RandSecureString(n int) (string, error) {
+	b := make([]byte, n)
+	letterCount := big.NewInt(int64(len(letterBytes)))
+	for i := range b {
+		index, err := crand.Int(crand.Reader, letterCount)
+		if err != nil {
+			return "", err
+		}
+		b[i] = letterBytes[index.Int64()]
+	}
+	return string(b), nil
+}
 
+func RandString(n int) string {
 	b := make([]byte, n)
 	for i := range b {
 		b[i] = letterBytes[rand.Int63()%int64(len(letterBytes))]
@@ -38,15 +38,18 @@ type Driver struct {
 }
 
 type ClusterConnection struct {
-	ClusterName         string              `yson:"cluster_name"`
-	PrimaryMaster       MasterCell          `yson:"primary_master"`
-	DiscoveryConnection DiscoveryConnection `yson:"discovery_connection,omitempty"`
+	ClusterName            string              `yson:"cluster_name"`
+	PrimaryMaster          MasterCell          `yson:"primary_master"`
+	DiscoveryConnection    DiscoveryConnection `yson:"discovery_connection,omitempty"`
+	MasterCache            *MasterCache        `yson:"master_cache,omitempty"`
+	ReplicatedTableTracker *AddressList        `yson:"replicated_table_tracker,omitempty"`
 }
 
 type AddressResolver struct {
-	EnableIPv4 bool `yson:"enable_ipv4"`
-	EnableIPv6 bool `yson:"enable_ipv6"`
-	Retries    *int `yson:"retries,omitempty"`
+	EnableIPv4 bool  `yson:"enable_ipv4"`
+	EnableIPv6 bool  `yson:"enable_ipv6"`
+	PreferIPv4 *bool `yson:"prefer_ipv4,omitempty"`
+	Retries    *int  `yson:"retries,omitempty"`
 }
 
 type PemBlob struct {
@@ -62,11 +65,20 @@ const (
 	EncryptionModeRequired EncryptionMode = "required"
 )
 
+type VerificationMode string
+
+const (
+	VerificationModeNone VerificationMode = "none"
+	VerificationModeCA   VerificationMode = "ca"
+)
+
 type Bus struct {
-	EncryptionMode EncryptionMode `yson:"encryption_mode,omitempty"`
-	CertChain      *PemBlob       `yson:"cert_chain,omitempty"`
-	PrivateKey     *PemBlob       `yson:"private_key,omitempty"`
-	CipherList     []string       `yson:"cipher_list,omitempty"`
+	EncryptionMode   EncryptionMode   `yson:"encryption_mode,omitempty"`
+	VerificationMode VerificationMode `yson:"verification_mode,omitempty"`
+	CA               *PemBlob         `yson:"ca,omitempty"`
+	CertChain        *PemBlob         `yson:"cert_chain,omitempty"`
+	PrivateKey       *PemBlob         `yson:"private_key,omitempty"`
+	CipherList       []string         `yson:"cipher_list,omitempty"`
 }
 
 type BusServer struct {
@@ -80,6 +92,7 @@ type BasicServer struct {
 	MonitoringPort  int32           `yson:"monitoring_port"`
 	RPCPort         int32           `yson:"rpc_port"`
 	BusServer       *BusServer      `yson:"bus_server,omitempty"`
+	BusClient       *Bus            `yson:"bus_client,omitempty"`
 }
 
 type CommonServer struct {
@@ -7,7 +7,10 @@ import (
 	"go.ytsaurus.tech/yt/go/yson"
 	corev1 "k8s.io/api/core/v1"
 	ptr "k8s.io/utils/pointer"
+	"net"
 	"path"
+	"strconv"
+	"strings"
 )
 
 type ConfigFormat string
@@ -40,17 +43,34 @@ func NewGenerator(ytsaurus *ytv1.Ytsaurus, clusterDomain string) *Generator {
 	}
 }
 
-func (g *Generator) getMasterAddresses() []string {
-	names := make([]string, 0, g.ytsaurus.Spec.PrimaryMasters.InstanceCount)
-	for _, podName := range g.GetMasterPodNames() {
-		names = append(names, fmt.Sprintf("%s.%s.%s.svc.%s:%d",
+// getPodAddresses returns the addresses of the pods behind the headless service.
+// In dual-stack clusters pod DNS records have addresses of both families,
+// so the family is chosen by the address resolver.
+func (g *Generator) getPodAddresses(podNames []string, serviceName string, port int) []string {
+	addresses := make([]string, 0, len(podNames))
+	for _, podName := range podNames {
+		host := fmt.Sprintf("%s.%s.%s.svc.%s",
 			podName,
-			g.GetMastersServiceName(),
+			serviceName,
 			g.ytsaurus.Namespace,
-			g.clusterDomain,
-			consts.MasterRPCPort))
+			g.clusterDomain)
+		addresses = append(addresses, net.JoinHostPort(host, strconv.Itoa(port)))
 	}
-	return names
+	return addresses
+}
+
+func (g *Generator) getMasterAddresses() []string {
+	if g.ytsaurus.Spec.HostNetwork && len(g.ytsaurus.Spec.PrimaryMasters.HostAddresses) != 0 {
+		// Masters find themselves in the peer list by their own address,
+		// which is the address of the node when host network is used.
+		names := make([]string, 0, len(g.ytsaurus.Spec.PrimaryMasters.HostAddresses))
+		for _, hostAddress := range g.ytsaurus.Spec.PrimaryMasters.HostAddresses {
+			names = append(names, net.JoinHostPort(hostAddress, strconv.Itoa(consts.MasterRPCPort)))
+		}
+		return names
+	}
+
+	return g.getPodAddresses(g.GetMasterPodNames(), g.GetMastersServiceName(), consts.MasterRPCPort)
 }
 
 func (g *Generator) getMasterHydraPeers() []HydraPeer {
@@ -64,47 +84,71 @@ func (g *Generator) getMasterHydraPeers() []HydraPeer {
 	return peers
 }
 
-func (g *Generator) getDiscoveryAddresses() []string {
-	names := make([]string, 0, g.ytsaurus.Spec.Discovery.InstanceCount)
-	for _, podName := range g.GetDiscoveryPodNames() {
-		names = append(names, fmt.Sprintf("%s.%s.%s.svc.%s:%d",
-			podName,
-			g.GetDiscoveryServiceName(),
-			g.ytsaurus.Namespace,
-			g.clusterDomain,
-			consts.DiscoveryRPCPort))
+func (g *Generator) getTimestampProviderAddresses() []string {
+	if g.ytsaurus.Spec.TimestampProviders == nil {
+		return g.getMasterAddresses()
 	}
-	return names
+
+	return g.getPodAddresses(g.GetTimestampProviderPodNames(), g.GetTimestampProvidersServiceName(), consts.TimestampProviderRPCPort)
+}
+
+func (g *Generator) GetReplicatedTableTrackerAddresses() []string {
+	return g.getPodAddresses(g.GetReplicatedTableTrackerPodNames(), g.GetReplicatedTableTrackerServiceName(), consts.ReplicatedTableTrackerRPCPort)
+}
+
+func (g *Generator) getMasterCacheAddresses() []string {
+	return g.getPodAddresses(g.GetMasterCachePodNames(), g.GetMasterCachesServiceName(), consts.MasterCacheRPCPort)
+}
+
+func (g *Generator) getDiscoveryAddresses() []string {
+	return g.getPodAddresses(g.GetDiscoveryPodNames(), g.GetDiscoveryServiceName(), consts.DiscoveryRPCPort)
 }
 
 func (g *Generator) GetYQLAgentAddresses() []string {
-	names := make([]string, 0, g.ytsaurus.Spec.YQLAgents.InstanceCount)
-	for _, podName := range g.GetYQLAgentPodNames() {
-		names = append(names, fmt.Sprintf("%s.%s.%s.svc.%s:%d",
-			podName,
-			g.GetYQLAgentServiceName(),
-			g.ytsaurus.Namespace,
-			g.clusterDomain,
-			consts.YQLAgentRPCPort))
-	}
-	return names
+	return g.getPodAddresses(g.GetYQLAgentPodNames(), g.GetYQLAgentServiceName(), consts.YQLAgentRPCPort)
+}
+
+func (g *Generator) GetQueueAgentAddresses() []string {
+	return g.getPodAddresses(g.GetQueueAgentPodNames(), g.GetQueueAgentServiceName(), consts.QueueAgentRPCPort)
 }
 
 func (g *Generator) fillDriver(c *Driver) {
-	c.TimestampProviders.Addresses = g.getMasterAddresses()
+	c.TimestampProviders.Addresses = g.getTimestampProviderAddresses()
 
 	c.PrimaryMaster.Addresses = g.getMasterAddresses()
 	c.PrimaryMaster.CellID = generateCellID(g.ytsaurus.Spec.PrimaryMasters.CellTag)
 
-	c.MasterCache.EnableMasterCacheDiscover = true
-	g.fillPrimaryMaster(&c.MasterCache.MasterCell)
+	g.fillMasterCache(&c.MasterCache)
+}
+
+func (g *Generator) fillMasterCache(c *MasterCache) {
+	if g.ytsaurus.Spec.MasterCaches == nil {
+		c.EnableMasterCacheDiscover = true
+		g.fillPrimaryMaster(&c.MasterCell)
+		return
+	}
+
+	c.Addresses = g.getMasterCacheAddresses()
+	c.CellID = generateCellID(g.ytsaurus.Spec.PrimaryMasters.CellTag)
+	c.EnableMasterCacheDiscover = false
 }
 
 func (g *Generator) fillAddressResolver(c *AddressResolver) {
+	fillAddressResolver(c, &g.ytsaurus.Spec.CommonSpec)
+}
+
+func fillAddressResolver(c *AddressResolver, commonSpec *ytv1.CommonSpec) {
 	var retries = 1000
 
-	c.EnableIPv4 = !g.ytsaurus.Spec.UseIPv6
-	c.EnableIPv6 = g.ytsaurus.Spec.UseIPv6
+	if ytv1.IsDualStack(commonSpec) {
+		// Both families are allowed, the one of useIpv6 is tried first.
+		c.EnableIPv4 = true
+		c.EnableIPv6 = true
+		c.PreferIPv4 = ptr.Bool(!commonSpec.UseIPv6)
+	} else {
+		c.EnableIPv4 = !commonSpec.UseIPv6
+		c.EnableIPv6 = commonSpec.UseIPv6
+	}
 	c.Retries = &retries
 }
 
@@ -118,17 +162,95 @@ func (g *Generator) fillClusterConnection(c *ClusterConnection) {
 	g.fillPrimaryMaster(&c.PrimaryMaster)
 	c.ClusterName = g.ytsaurus.Name
 	c.DiscoveryConnection.Addresses = g.getDiscoveryAddresses()
+
+	if g.ytsaurus.Spec.MasterCaches != nil {
+		c.MasterCache = &MasterCache{}
+		g.fillMasterCache(c.MasterCache)
+	}
+
+	if g.ytsaurus.Spec.ReplicatedTableTrackers != nil {
+		c.ReplicatedTableTracker = &AddressList{
+			Addresses: g.GetReplicatedTableTrackerAddresses(),
+		}
+	}
 }
 
 func (g *Generator) fillCommonService(c *CommonServer) {
 	// ToDo(psushin): enable porto resource tracker?
 	g.fillAddressResolver(&c.AddressResolver)
 	g.fillClusterConnection(&c.ClusterConnection)
-	c.TimestampProviders.Addresses = g.getMasterAddresses()
+	c.TimestampProviders.Addresses = g.getTimestampProviderAddresses()
+	g.fillInternalTransport(&c.BasicServer)
+}
+
+func (g *Generator) getInternalTransportEncryptionMode() EncryptionMode {
+	return getEncryptionMode(g.ytsaurus.Status.InternalTransportEncryptionMode)
+}
+
+func getEncryptionMode(mode ytv1.BusEncryptionMode) EncryptionMode {
+	switch mode {
+	case ytv1.BusEncryptionModeOptional:
+		return EncryptionModeOptional
+	case ytv1.BusEncryptionModeRequired:
+		return EncryptionModeRequired
+	default:
+		return EncryptionModeDisabled
+	}
+}
+
+func (g *Generator) getInternalTransportBusClient(withCA bool) *Bus {
+	return getInternalTransportBusClient(g.getInternalTransportEncryptionMode(), g.ytsaurus.Spec.InternalTransport, withCA)
 }
 
+// getInternalTransportBusClient returns the config for the outgoing connections,
+// which verify peers only if the CA is available to the process.
+func getInternalTransportBusClient(mode EncryptionMode, transport *ytv1.InternalTransportSpec, withCA bool) *Bus {
+	if mode == EncryptionModeDisabled {
+		return nil
+	}
+
+	b := &Bus{
+		EncryptionMode:   mode,
+		VerificationMode: VerificationModeNone,
+	}
+	if withCA && transport != nil && transport.CASecret != nil {
+		b.VerificationMode = VerificationModeCA
+		b.CA = &PemBlob{
+			FileName: path.Join(consts.BusCASecretMountPoint, consts.CACertSecretKey),
+		}
+	}
+	return b
+}
+
+// fillInternalTransport uses the mode from status rather than spec,
+// so that the encryption is switched on and off in steps.
+func (g *Generator) fillInternalTransport(c *BasicServer) {
+	fillInternalTransport(c, g.getInternalTransportEncryptionMode(), g.ytsaurus.Spec.InternalTransport)
+}
+
+func fillInternalTransport(c *BasicServer, mode EncryptionMode, transport *ytv1.InternalTransportSpec) {
+	if mode == EncryptionModeDisabled {
+		return
+	}
+
+	c.BusServer = &BusServer{
+		Bus: Bus{
+			EncryptionMode: mode,
+			CertChain: &PemBlob{
+				FileName: path.Join(consts.BusSecretMountPoint, corev1.TLSCertKey),
+			},
+			PrivateKey: &PemBlob{
+				FileName: path.Join(consts.BusSecretMountPoint, corev1.TLSPrivateKeyKey),
+			},
+		},
+	}
+	c.BusClient = getInternalTransportBusClient(mode, transport, true)
+}
+
+// fillBusEncryption applies the public transport settings on top of the internal ones,
+// the encryption mode of the cluster is kept if it is stricter.
 func (g *Generator) fillBusEncryption(b *Bus, s *ytv1.RPCTransportSpec) {
-	if s.TLSRequired {
+	if s.TLSRequired || b.EncryptionMode == EncryptionModeRequired {
 		b.EncryptionMode = EncryptionModeRequired
 	} else {
 		b.EncryptionMode = EncryptionModeOptional
@@ -158,6 +280,12 @@ func (g *Generator) GetClusterConnection() ([]byte, error) {
 	return marshallYsonConfig(c)
 }
 
+// GetRemoteClusterConnection generates the cluster connection of another cluster
+// managed by the operator in the same Kubernetes cluster.
+func (g *Generator) GetRemoteClusterConnection(remote *ytv1.Ytsaurus) ([]byte, error) {
+	return NewGenerator(remote, g.clusterDomain).GetClusterConnection()
+}
+
 func (g *Generator) GetStrawberryControllerConfig() ([]byte, error) {
 	c := getStrawberryController()
 	c.LocationProxies = []string{
@@ -178,6 +306,8 @@ func (g *Generator) getMasterConfigImpl() (MasterServer, error) {
 		return MasterServer{}, err
 	}
 	g.fillCommonService(&c.CommonServer)
+	// Masters are the source of timestamps themselves.
+	c.TimestampProviders.Addresses = g.getMasterAddresses()
 	g.fillPrimaryMaster(&c.PrimaryMaster)
 	configureMasterServerCypressManager(g.ytsaurus.Spec, &c.CypressManager)
 	return c, nil
@@ -200,6 +330,8 @@ func (g *Generator) GetNativeClientConfig() ([]byte, error) {
 	g.fillDriver(&c.Driver)
 	g.fillAddressResolver(&c.AddressResolver)
 	c.Driver.APIVersion = 4
+	// Native clients run in jobs without the CA mounted.
+	c.BusClient = g.getInternalTransportBusClient(false)
 
 	return marshallYsonConfig(c)
 }
@@ -252,6 +384,13 @@ func (g *Generator) getRPCProxyConfigImpl(spec ytv1.RPCProxiesSpec) (RPCProxySer
 		c.RequireAuthentication = true
 	}
 
+	if spec.Transport.TLSSecret != nil {
+		if c.BusServer == nil {
+			c.BusServer = &BusServer{}
+		}
+		g.fillBusEncryption(&c.BusServer.Bus, &spec.Transport)
+	}
+
 	return c, nil
 }
 
@@ -261,13 +400,6 @@ func (g *Generator) GetRPCProxyConfig(spec ytv1.RPCProxiesSpec) ([]byte, error)
 		return []byte{}, err
 	}
 
-	if spec.Transport.TLSSecret != nil {
-		if c.BusServer == nil {
-			c.BusServer = &BusServer{}
-		}
-		g.fillBusEncryption(&c.BusServer.Bus, &spec.Transport)
-	}
-
 	return marshallYsonConfig(c)
 }
 
@@ -398,6 +530,15 @@ func (g *Generator) getHTTPProxyConfigImpl(spec ytv1.HTTPProxiesSpec) (HTTPProxy
 		c.Auth.OauthTokenAuthenticator = &OauthTokenAuthenticator{}
 	}
 
+	if auth := g.ytsaurus.Spec.Authentication; auth != nil {
+		if auth.Oidc != nil {
+			c.Auth.OidcAuthenticator = getOidcAuthenticator(*auth.Oidc)
+		}
+		if auth.Ldap != nil {
+			c.Auth.LdapAuthenticator = getLdapAuthenticator(*auth.Ldap)
+		}
+	}
+
 	return c, nil
 }
 
@@ -433,6 +574,99 @@ func (g *Generator) GetQueryTrackerConfig() ([]byte, error) {
 	return marshallYsonConfig(c)
 }
 
+func (g *Generator) getQueueAgentConfigImpl() (QueueAgentServer, error) {
+	c, err := getQueueAgentServerCarcass(*g.ytsaurus.Spec.QueueAgents)
+	if err != nil {
+		return c, err
+	}
+	g.fillCommonService(&c.CommonServer)
+
+	return c, nil
+}
+
+func (g *Generator) GetQueueAgentConfig() ([]byte, error) {
+	if g.ytsaurus.Spec.QueueAgents == nil {
+		return []byte{}, nil
+	}
+
+	c, err := g.getQueueAgentConfigImpl()
+	if err != nil {
+		return nil, err
+	}
+
+	return marshallYsonConfig(c)
+}
+
+func (g *Generator) getTabletBalancerConfigImpl() (TabletBalancerServer, error) {
+	c, err := getTabletBalancerServerCarcass(*g.ytsaurus.Spec.TabletBalancers)
+	if err != nil {
+		return c, err
+	}
+	g.fillCommonService(&c.CommonServer)
+
+	return c, nil
+}
+
+func (g *Generator) GetTabletBalancerConfig() ([]byte, error) {
+	if g.ytsaurus.Spec.TabletBalancers == nil {
+		return []byte{}, nil
+	}
+
+	c, err := g.getTabletBalancerConfigImpl()
+	if err != nil {
+		return nil, err
+	}
+
+	return marshallYsonConfig(c)
+}
+
+func (g *Generator) getCellBalancerConfigImpl() (CellBalancerServer, error) {
+	c, err := getCellBalancerServerCarcass(*g.ytsaurus.Spec.CellBalancers)
+	if err != nil {
+		return c, err
+	}
+	g.fillCommonService(&c.CommonServer)
+	c.BundleController.Cluster = g.ytsaurus.Name
+
+	return c, nil
+}
+
+func (g *Generator) GetCellBalancerConfig() ([]byte, error) {
+	if g.ytsaurus.Spec.CellBalancers == nil {
+		return []byte{}, nil
+	}
+
+	c, err := g.getCellBalancerConfigImpl()
+	if err != nil {
+		return nil, err
+	}
+
+	return marshallYsonConfig(c)
+}
+
+func (g *Generator) getReplicatedTableTrackerConfigImpl() (ReplicatedTableTrackerServer, error) {
+	c, err := getReplicatedTableTrackerServerCarcass(*g.ytsaurus.Spec.ReplicatedTableTrackers)
+	if err != nil {
+		return c, err
+	}
+	g.fillCommonService(&c.CommonServer)
+
+	return c, nil
+}
+
+func (g *Generator) GetReplicatedTableTrackerConfig() ([]byte, error) {
+	if g.ytsaurus.Spec.ReplicatedTableTrackers == nil {
+		return []byte{}, nil
+	}
+
+	c, err := g.getReplicatedTableTrackerConfigImpl()
+	if err != nil {
+		return nil, err
+	}
+
+	return marshallYsonConfig(c)
+}
+
 func (g *Generator) getYQLAgentConfigImpl() (YQLAgentServer, error) {
 	c, err := getYQLAgentServerCarcass(*g.ytsaurus.Spec.YQLAgents)
 	if err != nil {
@@ -466,7 +700,17 @@ func (g *Generator) GetUIClustersConfig() ([]byte, error) {
 	c.ID = g.ytsaurus.Name
 	c.Name = g.ytsaurus.Name
 	c.Proxy = g.GetHTTPProxiesAddress(consts.DefaultHTTPProxyRole)
+	// Browsers send some requests to the proxies directly, so they need the external address.
+	for _, hp := range g.ytsaurus.Spec.HTTPProxies {
+		if hp.Role == consts.DefaultHTTPProxyRole && hp.ExternalAccess != nil {
+			c.ExternalProxy = hp.ExternalAccess.Hostname
+		}
+	}
 	c.PrimaryMaster.CellTag = g.ytsaurus.Spec.PrimaryMasters.CellTag
+	// Users of LDAP log in with their passwords, so basic authentication is kept for them.
+	if auth := g.ytsaurus.Spec.Authentication; auth != nil && auth.Oidc != nil {
+		c.Authentication = uiAuthenticationOAuth
+	}
 
 	c.Theme = g.ytsaurus.Spec.UI.Theme
 	c.Environment = g.ytsaurus.Spec.UI.Environment
@@ -490,7 +734,63 @@ func (g *Generator) GetUICustomConfig() ([]byte, error) {
 	c := UICustom{
 		OdinBaseUrl: g.ytsaurus.Spec.UI.OdinBaseUrl,
 	}
+	// The client secret is passed to the UI in the environment.
+	if auth := g.ytsaurus.Spec.Authentication; auth != nil && auth.Oidc != nil {
+		c.OAuthSettings = &UIOAuthSettings{
+			BaseURL:  auth.Oidc.Issuer,
+			ClientID: auth.Oidc.ClientID,
+			Scope:    strings.Join(auth.Oidc.Scopes, " "),
+		}
+	}
+
+	return marshallYsonConfig(c)
+}
+
+func (g *Generator) getMasterCacheConfigImpl() (MasterCacheServer, error) {
+	c, err := getMasterCacheServerCarcass(*g.ytsaurus.Spec.MasterCaches)
+	if err != nil {
+		return c, err
+	}
+
+	g.fillCommonService(&c.CommonServer)
+	// Master caches must talk to the masters directly.
+	c.ClusterConnection.MasterCache = nil
+	return c, nil
+}
+
+func (g *Generator) GetMasterCacheConfig() ([]byte, error) {
+	if g.ytsaurus.Spec.MasterCaches == nil {
+		return []byte{}, nil
+	}
+
+	c, err := g.getMasterCacheConfigImpl()
+	if err != nil {
+		return nil, err
+	}
+	return marshallYsonConfig(c)
+}
+
+func (g *Generator) getTimestampProviderConfigImpl() (TimestampProviderServer, error) {
+	c, err := getTimestampProviderServerCarcass(*g.ytsaurus.Spec.TimestampProviders)
+	if err != nil {
+		return c, err
+	}
+
+	g.fillCommonService(&c.CommonServer)
+	// Timestamp providers fetch timestamps from masters.
+	c.TimestampProviders.Addresses = g.getMasterAddresses()
+	return c, nil
+}
+
+func (g *Generator) GetTimestampProviderConfig() ([]byte, error) {
+	if g.ytsaurus.Spec.TimestampProviders == nil {
+		return []byte{}, nil
+	}
 
+	c, err := g.getTimestampProviderConfigImpl()
+	if err != nil {
+		return nil, err
+	}
 	return marshallYsonConfig(c)
 }
 
@@ -3,11 +3,14 @@ package ytconfig
 import (
 	"testing"
 
+	. "github.com/onsi/gomega"
 	v1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
 	"github.com/ytsaurus/yt-k8s-operator/pkg/canonize"
+	"go.ytsaurus.tech/yt/go/yson"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ptr "k8s.io/utils/pointer"
 )
 
 type Volume struct {
@@ -27,7 +30,9 @@ func TestGetMasterConfig(t *testing.T) {
 			Name:      "test",
 		},
 		Spec: v1.YtsaurusSpec{
-			UseIPv6: true,
+			CommonSpec: v1.CommonSpec{
+				UseIPv6: true,
+			},
 
 			PrimaryMasters: v1.MastersSpec{
 				CellTag: 0,
@@ -116,3 +121,543 @@ func TestGetMasterConfig(t *testing.T) {
 
 	canonize.Assert(t, mc)
 }
+
+func TestGetMasterAddressesWithHostNetwork(t *testing.T) {
+	g := NewWithT(t)
+
+	ytsaurus := &v1.Ytsaurus{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake",
+			Name:      "test",
+		},
+		Spec: v1.YtsaurusSpec{
+			PrimaryMasters: v1.MastersSpec{
+				InstanceSpec: v1.InstanceSpec{
+					InstanceCount: 2,
+				},
+				HostAddresses: []string{"host-1.fake.zone", "host-2.fake.zone"},
+			},
+		},
+	}
+
+	generator := NewGenerator(ytsaurus, "fake.zone")
+	// Host addresses are ignored without host network.
+	g.Expect(generator.getMasterAddresses()).Should(Equal([]string{
+		"ms-test-0.masters-test.fake.svc.fake.zone:9010",
+		"ms-test-1.masters-test.fake.svc.fake.zone:9010",
+	}))
+
+	ytsaurus.Spec.HostNetwork = true
+	var cell MasterCell
+	generator.fillPrimaryMaster(&cell)
+	g.Expect(cell.Addresses).Should(Equal([]string{"host-1.fake.zone:9010", "host-2.fake.zone:9010"}))
+	g.Expect(cell.Peers).Should(Equal([]HydraPeer{
+		{Address: "host-1.fake.zone:9010", Voting: true},
+		{Address: "host-2.fake.zone:9010", Voting: true},
+	}))
+}
+
+func TestGetRPCProxyConfigBusEncryption(t *testing.T) {
+	g := NewWithT(t)
+
+	ytsaurus := &v1.Ytsaurus{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake",
+			Name:      "test",
+		},
+		Spec: v1.YtsaurusSpec{
+			InternalTransport: &v1.InternalTransportSpec{
+				EncryptionMode: v1.BusEncryptionModeRequired,
+				CASecret:       &corev1.LocalObjectReference{Name: "ca"},
+			},
+		},
+	}
+	spec := v1.RPCProxiesSpec{
+		Transport: v1.RPCTransportSpec{
+			TLSSecret: &corev1.LocalObjectReference{Name: "rpc-tls"},
+		},
+	}
+	publicCertChain := &PemBlob{FileName: "/config/rpc_secret/tls.crt"}
+	publicPrivateKey := &PemBlob{FileName: "/config/rpc_secret/tls.key"}
+
+	// Without internal encryption the proxy serves its public certificate only.
+	c, err := NewGenerator(ytsaurus, "fake.zone").getRPCProxyConfigImpl(spec)
+	g.Expect(err).Should(Succeed())
+	g.Expect(c.BusServer.Bus).Should(Equal(Bus{
+		EncryptionMode: EncryptionModeOptional,
+		CertChain:      publicCertChain,
+		PrivateKey:     publicPrivateKey,
+	}))
+	g.Expect(c.BusClient).Should(BeNil())
+
+	// The required mode of the cluster is not relaxed by the proxy.
+	ytsaurus.Status.InternalTransportEncryptionMode = v1.BusEncryptionModeRequired
+	c, err = NewGenerator(ytsaurus, "fake.zone").getRPCProxyConfigImpl(spec)
+	g.Expect(err).Should(Succeed())
+	g.Expect(c.BusServer.Bus).Should(Equal(Bus{
+		EncryptionMode: EncryptionModeRequired,
+		CertChain:      publicCertChain,
+		PrivateKey:     publicPrivateKey,
+	}))
+	g.Expect(c.BusClient).Should(Equal(&Bus{
+		EncryptionMode:   EncryptionModeRequired,
+		VerificationMode: VerificationModeCA,
+		CA:               &PemBlob{FileName: "/config/bus_ca_secret/ca.crt"},
+	}))
+
+	// The proxy may require encryption of the optional cluster.
+	ytsaurus.Status.InternalTransportEncryptionMode = v1.BusEncryptionModeOptional
+	spec.Transport.TLSRequired = true
+	c, err = NewGenerator(ytsaurus, "fake.zone").getRPCProxyConfigImpl(spec)
+	g.Expect(err).Should(Succeed())
+	g.Expect(c.BusServer.EncryptionMode).Should(Equal(EncryptionModeRequired))
+}
+
+func TestGetRemoteNodeConfigBusEncryption(t *testing.T) {
+	g := NewWithT(t)
+
+	ytsaurus := &v1.Ytsaurus{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake",
+			Name:      "test",
+		},
+		Spec: v1.YtsaurusSpec{
+			PrimaryMasters: v1.MastersSpec{
+				InstanceSpec: v1.InstanceSpec{
+					InstanceCount: 1,
+				},
+			},
+		},
+	}
+	clusterConnection, err := NewGenerator(ytsaurus, "fake.zone").GetClusterConnection()
+	g.Expect(err).Should(Succeed())
+
+	// Remote nodes without internal transport have no bus configs.
+	generator, err := NewRemoteNodeGenerator("remote", &v1.CommonSpec{}, nil, clusterConnection)
+	g.Expect(err).Should(Succeed())
+	var c CommonServer
+	generator.fillCommonService(&c)
+	g.Expect(c.BusServer).Should(BeNil())
+	g.Expect(c.BusClient).Should(BeNil())
+
+	// The mode of remote nodes is taken from spec at once.
+	transport := &v1.InternalTransportSpec{
+		EncryptionMode: v1.BusEncryptionModeRequired,
+		CASecret:       &corev1.LocalObjectReference{Name: "ca"},
+	}
+	generator, err = NewRemoteNodeGenerator("remote", &v1.CommonSpec{}, transport, clusterConnection)
+	g.Expect(err).Should(Succeed())
+	g.Expect(generator.GetInternalTransportEncryptionMode()).Should(Equal(v1.BusEncryptionModeRequired))
+	generator.fillCommonService(&c)
+	g.Expect(c.BusServer.Bus).Should(Equal(Bus{
+		EncryptionMode: EncryptionModeRequired,
+		CertChain:      &PemBlob{FileName: "/config/bus_secret/tls.crt"},
+		PrivateKey:     &PemBlob{FileName: "/config/bus_secret/tls.key"},
+	}))
+	g.Expect(c.BusClient).Should(Equal(&Bus{
+		EncryptionMode:   EncryptionModeRequired,
+		VerificationMode: VerificationModeCA,
+		CA:               &PemBlob{FileName: "/config/bus_ca_secret/ca.crt"},
+	}))
+}
+
+func TestGetInternalTransportConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	ytsaurus := &v1.Ytsaurus{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake",
+			Name:      "test",
+		},
+		Spec: v1.YtsaurusSpec{
+			PrimaryMasters: v1.MastersSpec{
+				InstanceSpec: v1.InstanceSpec{
+					InstanceCount: 1,
+				},
+			},
+			InternalTransport: &v1.InternalTransportSpec{
+				EncryptionMode: v1.BusEncryptionModeRequired,
+				CASecret:       &corev1.LocalObjectReference{Name: "ca"},
+			},
+		},
+	}
+	busServer := func(mode EncryptionMode) *BusServer {
+		return &BusServer{
+			Bus: Bus{
+				EncryptionMode: mode,
+				CertChain:      &PemBlob{FileName: "/config/bus_secret/tls.crt"},
+				PrivateKey:     &PemBlob{FileName: "/config/bus_secret/tls.key"},
+			},
+		}
+	}
+
+	// The mode is taken from status, so nothing is encrypted until the switch.
+	c, err := NewGenerator(ytsaurus, "fake.zone").getRPCProxyConfigImpl(v1.RPCProxiesSpec{})
+	g.Expect(err).Should(Succeed())
+	g.Expect(c.BusServer).Should(BeNil())
+	g.Expect(c.BusClient).Should(BeNil())
+
+	for _, mode := range []EncryptionMode{EncryptionModeOptional, EncryptionModeRequired} {
+		ytsaurus.Status.InternalTransportEncryptionMode = v1.BusEncryptionMode(mode)
+		generator := NewGenerator(ytsaurus, "fake.zone")
+
+		c, err = generator.getRPCProxyConfigImpl(v1.RPCProxiesSpec{})
+		g.Expect(err).Should(Succeed())
+		g.Expect(c.BusServer).Should(Equal(busServer(mode)))
+		g.Expect(c.BusClient).Should(Equal(&Bus{
+			EncryptionMode:   mode,
+			VerificationMode: VerificationModeCA,
+			CA:               &PemBlob{FileName: "/config/bus_ca_secret/ca.crt"},
+		}))
+
+		// Native clients run in jobs without the CA, so they don't verify peers.
+		data, err := generator.GetNativeClientConfig()
+		g.Expect(err).Should(Succeed())
+		var client NativeClient
+		g.Expect(yson.Unmarshal(data, &client)).Should(Succeed())
+		g.Expect(client.BusClient).Should(Equal(&Bus{
+			EncryptionMode:   mode,
+			VerificationMode: VerificationModeNone,
+		}))
+	}
+
+	// Peers are not verified without the CA.
+	ytsaurus.Spec.InternalTransport.CASecret = nil
+	c, err = NewGenerator(ytsaurus, "fake.zone").getRPCProxyConfigImpl(v1.RPCProxiesSpec{})
+	g.Expect(err).Should(Succeed())
+	g.Expect(c.BusClient.VerificationMode).Should(Equal(VerificationModeNone))
+	g.Expect(c.BusClient.CA).Should(BeNil())
+}
+
+func TestGetQueueAgentConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	ytsaurus := &v1.Ytsaurus{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake",
+			Name:      "test",
+		},
+		Spec: v1.YtsaurusSpec{
+			PrimaryMasters: v1.MastersSpec{
+				InstanceSpec: v1.InstanceSpec{
+					InstanceCount: 1,
+				},
+			},
+		},
+	}
+
+	// Nothing is generated without queue agents in the spec.
+	data, err := NewGenerator(ytsaurus, "fake.zone").GetQueueAgentConfig()
+	g.Expect(err).Should(Succeed())
+	g.Expect(data).Should(BeEmpty())
+
+	ytsaurus.Spec.QueueAgents = &v1.QueueAgentSpec{
+		InstanceSpec: v1.InstanceSpec{
+			InstanceCount: 2,
+		},
+	}
+	generator := NewGenerator(ytsaurus, "fake.zone")
+	c, err := generator.getQueueAgentConfigImpl()
+	g.Expect(err).Should(Succeed())
+
+	g.Expect(c.RPCPort).Should(Equal(int32(9030)))
+	g.Expect(c.MonitoringPort).Should(Equal(int32(10030)))
+	g.Expect(c.User).Should(Equal("queue_agent"))
+	g.Expect(c.QueueAgent.Stage).Should(Equal("production"))
+	g.Expect(c.CypressSynchronizer.Policy).Should(Equal("watching"))
+	g.Expect(c.ClusterConnection.ClusterName).Should(Equal("test"))
+	g.Expect(c.Logging.Writers).Should(HaveKey("info"))
+
+	g.Expect(generator.GetQueueAgentAddresses()).Should(Equal([]string{
+		"qa-test-0.queue-agents-test.fake.svc.fake.zone:9030",
+		"qa-test-1.queue-agents-test.fake.svc.fake.zone:9030",
+	}))
+}
+
+func TestFillAddressResolver(t *testing.T) {
+	g := NewWithT(t)
+
+	singleStack := corev1.IPFamilyPolicySingleStack
+	preferDualStack := corev1.IPFamilyPolicyPreferDualStack
+
+	for _, tc := range []struct {
+		name                   string
+		useIPv6                bool
+		policy                 *corev1.IPFamilyPolicyType
+		enableIPv4, enableIPv6 bool
+		preferIPv4             *bool
+	}{
+		{"IPv4", false, nil, true, false, nil},
+		{"IPv6", true, nil, false, true, nil},
+		{"single-stack IPv6", true, &singleStack, false, true, nil},
+		{"dual-stack with IPv4 first", false, &preferDualStack, true, true, ptr.Bool(true)},
+		{"dual-stack with IPv6 first", true, &preferDualStack, true, true, ptr.Bool(false)},
+	} {
+		var c AddressResolver
+		fillAddressResolver(&c, &v1.CommonSpec{UseIPv6: tc.useIPv6, IPFamilyPolicy: tc.policy})
+
+		g.Expect(c.EnableIPv4).Should(Equal(tc.enableIPv4), tc.name)
+		g.Expect(c.EnableIPv6).Should(Equal(tc.enableIPv6), tc.name)
+		g.Expect(c.PreferIPv4).Should(Equal(tc.preferIPv4), tc.name)
+		g.Expect(c.Retries).ShouldNot(BeNil(), tc.name)
+	}
+
+	// The resolver is filled the same way in the configs of all components.
+	ytsaurus := &v1.Ytsaurus{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake",
+			Name:      "test",
+		},
+		Spec: v1.YtsaurusSpec{
+			CommonSpec: v1.CommonSpec{
+				UseIPv6:        true,
+				IPFamilyPolicy: &preferDualStack,
+			},
+			PrimaryMasters: v1.MastersSpec{
+				InstanceSpec: v1.InstanceSpec{
+					InstanceCount: 1,
+				},
+			},
+		},
+	}
+	c, err := NewGenerator(ytsaurus, "fake.zone").getRPCProxyConfigImpl(v1.RPCProxiesSpec{})
+	g.Expect(err).Should(Succeed())
+	g.Expect(c.AddressResolver.EnableIPv4).Should(BeTrue())
+	g.Expect(c.AddressResolver.EnableIPv6).Should(BeTrue())
+	g.Expect(c.AddressResolver.PreferIPv4).Should(Equal(ptr.Bool(false)))
+}
+
+// getTestYtsaurus returns the minimal cluster with a single master.
+func getTestYtsaurus() *v1.Ytsaurus {
+	return &v1.Ytsaurus{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake",
+			Name:      "test",
+		},
+		Spec: v1.YtsaurusSpec{
+			PrimaryMasters: v1.MastersSpec{
+				InstanceSpec: v1.InstanceSpec{
+					InstanceCount: 1,
+				},
+			},
+		},
+	}
+}
+
+func TestGetMasterCacheConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	ytsaurus := getTestYtsaurus()
+	ytsaurus.Spec.MasterCaches = &v1.MasterCachesSpec{
+		InstanceSpec: v1.InstanceSpec{
+			InstanceCount: 2,
+		},
+	}
+
+	generator := NewGenerator(ytsaurus, "fake.zone")
+	mc, err := generator.GetMasterCacheConfig()
+	g.Expect(err).Should(Succeed())
+	canonize.Assert(t, mc)
+
+	// Components connect to the cluster via master caches.
+	data, err := generator.GetClusterConnection()
+	g.Expect(err).Should(Succeed())
+	var connection ClusterConnection
+	g.Expect(yson.Unmarshal(data, &connection)).Should(Succeed())
+	g.Expect(connection.MasterCache).ShouldNot(BeNil())
+	g.Expect(connection.MasterCache.Addresses).Should(Equal([]string{
+		"msc-test-0.master-caches-test.fake.svc.fake.zone:9018",
+		"msc-test-1.master-caches-test.fake.svc.fake.zone:9018",
+	}))
+	g.Expect(connection.MasterCache.EnableMasterCacheDiscover).Should(BeFalse())
+
+	// Without master caches components connect to masters directly.
+	ytsaurus.Spec.MasterCaches = nil
+	data, err = NewGenerator(ytsaurus, "fake.zone").GetClusterConnection()
+	g.Expect(err).Should(Succeed())
+	connection = ClusterConnection{}
+	g.Expect(yson.Unmarshal(data, &connection)).Should(Succeed())
+	g.Expect(connection.MasterCache).Should(BeNil())
+	g.Expect(connection.PrimaryMaster.Addresses).Should(Equal([]string{
+		"ms-test-0.masters-test.fake.svc.fake.zone:9010",
+	}))
+}
+
+func TestGetTimestampProviderConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	ytsaurus := getTestYtsaurus()
+	masterAddresses := []string{"ms-test-0.masters-test.fake.svc.fake.zone:9010"}
+	getNativeClient := func() NativeClient {
+		data, err := NewGenerator(ytsaurus, "fake.zone").GetNativeClientConfig()
+		g.Expect(err).Should(Succeed())
+		var client NativeClient
+		g.Expect(yson.Unmarshal(data, &client)).Should(Succeed())
+		return client
+	}
+
+	// Masters serve timestamps without timestamp providers.
+	g.Expect(getNativeClient().Driver.TimestampProviders.Addresses).Should(Equal(masterAddresses))
+	c, err := NewGenerator(ytsaurus, "fake.zone").getRPCProxyConfigImpl(v1.RPCProxiesSpec{})
+	g.Expect(err).Should(Succeed())
+	g.Expect(c.TimestampProviders.Addresses).Should(Equal(masterAddresses))
+
+	ytsaurus.Spec.TimestampProviders = &v1.TimestampProvidersSpec{
+		InstanceSpec: v1.InstanceSpec{
+			InstanceCount: 2,
+		},
+	}
+	generator := NewGenerator(ytsaurus, "fake.zone")
+	tp, err := generator.GetTimestampProviderConfig()
+	g.Expect(err).Should(Succeed())
+	canonize.Assert(t, tp)
+
+	providerAddresses := []string{
+		"tsp-test-0.timestamp-providers-test.fake.svc.fake.zone:9015",
+		"tsp-test-1.timestamp-providers-test.fake.svc.fake.zone:9015",
+	}
+	g.Expect(getNativeClient().Driver.TimestampProviders.Addresses).Should(Equal(providerAddresses))
+	c, err = generator.getRPCProxyConfigImpl(v1.RPCProxiesSpec{})
+	g.Expect(err).Should(Succeed())
+	g.Expect(c.TimestampProviders.Addresses).Should(Equal(providerAddresses))
+}
+
+func TestGetTabletBalancerConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	ytsaurus := getTestYtsaurus()
+	// Nothing is generated without tablet balancers in the spec.
+	data, err := NewGenerator(ytsaurus, "fake.zone").GetTabletBalancerConfig()
+	g.Expect(err).Should(Succeed())
+	g.Expect(data).Should(BeEmpty())
+
+	ytsaurus.Spec.TabletBalancers = &v1.TabletBalancersSpec{
+		InstanceSpec: v1.InstanceSpec{
+			InstanceCount: 1,
+		},
+	}
+	tb, err := NewGenerator(ytsaurus, "fake.zone").GetTabletBalancerConfig()
+	g.Expect(err).Should(Succeed())
+	canonize.Assert(t, tb)
+}
+
+func TestGetCellBalancerConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	ytsaurus := getTestYtsaurus()
+	// Nothing is generated without cell balancers in the spec.
+	data, err := NewGenerator(ytsaurus, "fake.zone").GetCellBalancerConfig()
+	g.Expect(err).Should(Succeed())
+	g.Expect(data).Should(BeEmpty())
+
+	ytsaurus.Spec.CellBalancers = &v1.CellBalancersSpec{
+		InstanceSpec: v1.InstanceSpec{
+			InstanceCount: 1,
+		},
+	}
+	cb, err := NewGenerator(ytsaurus, "fake.zone").GetCellBalancerConfig()
+	g.Expect(err).Should(Succeed())
+	canonize.Assert(t, cb)
+}
+
+func TestGetReplicatedTableTrackerConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	ytsaurus := getTestYtsaurus()
+	// Nothing is generated without replicated table trackers in the spec.
+	data, err := NewGenerator(ytsaurus, "fake.zone").GetReplicatedTableTrackerConfig()
+	g.Expect(err).Should(Succeed())
+	g.Expect(data).Should(BeEmpty())
+
+	ytsaurus.Spec.ReplicatedTableTrackers = &v1.ReplicatedTableTrackerSpec{
+		InstanceSpec: v1.InstanceSpec{
+			InstanceCount: 1,
+		},
+	}
+	rtt, err := NewGenerator(ytsaurus, "fake.zone").GetReplicatedTableTrackerConfig()
+	g.Expect(err).Should(Succeed())
+	canonize.Assert(t, rtt)
+}
+
+func TestGetHTTPProxyConfigAuthentication(t *testing.T) {
+	g := NewWithT(t)
+
+	ytsaurus := getTestYtsaurus()
+	ytsaurus.Spec.UI = &v1.UISpec{}
+	ytsaurus.Spec.Authentication = &v1.AuthenticationSpec{
+		Oidc: &v1.OidcSpec{
+			Issuer:   "https://sso.example.com/realms/yt",
+			ClientID: "ytsaurus",
+			ClientSecret: corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "oidc"},
+				Key:                  "client-secret",
+			},
+			Scopes:       []string{"openid", "profile", "groups"},
+			LoginClaim:   "preferred_username",
+			GroupsClaim:  "groups",
+			GroupMapping: map[string]string{"yt-admins": "superusers"},
+		},
+		Ldap: &v1.LdapSpec{
+			Host:   "ldap.example.com",
+			Port:   636,
+			Secure: true,
+			BindDN: "cn=yt,dc=example,dc=com",
+			BindPassword: corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "ldap"},
+				Key:                  "password",
+			},
+			UserSearchBase:  "ou=users,dc=example,dc=com",
+			UserFilter:      "(uid=%s)",
+			GroupSearchBase: "ou=groups,dc=example,dc=com",
+			GroupMapping:    map[string]string{"analysts": "users"},
+		},
+	}
+
+	generator := NewGenerator(ytsaurus, "fake.zone")
+	hp, err := generator.GetHTTPProxyConfig(v1.HTTPProxiesSpec{Role: "default"})
+	g.Expect(err).Should(Succeed())
+	canonize.Assert(t, hp)
+
+	// Secrets are read from the mounted files, they are never put into the config.
+	c, err := generator.getHTTPProxyConfigImpl(v1.HTTPProxiesSpec{Role: "default"})
+	g.Expect(err).Should(Succeed())
+	g.Expect(c.Auth.OidcAuthenticator.ClientSecretFile).Should(Equal("/config/oidc_secret/client-secret"))
+	g.Expect(c.Auth.LdapAuthenticator.BindPasswordFile).Should(Equal("/config/ldap_secret/password"))
+
+	// The UI logs in with the same identity provider.
+	data, err := generator.GetUIClustersConfig()
+	g.Expect(err).Should(Succeed())
+	var clusters UIClusters
+	g.Expect(yson.Unmarshal(data, &clusters)).Should(Succeed())
+	g.Expect(clusters.Clusters[0].Authentication).Should(Equal(uiAuthenticationOAuth))
+
+	data, err = generator.GetUICustomConfig()
+	g.Expect(err).Should(Succeed())
+	var custom UICustom
+	g.Expect(yson.Unmarshal(data, &custom)).Should(Succeed())
+	g.Expect(custom.OAuthSettings).Should(Equal(&UIOAuthSettings{
+		BaseURL:  "https://sso.example.com/realms/yt",
+		ClientID: "ytsaurus",
+		Scope:    "openid profile groups",
+	}))
+
+	// Users of LDAP log in with passwords.
+	ytsaurus.Spec.Authentication.Oidc = nil
+	generator = NewGenerator(ytsaurus, "fake.zone")
+	c, err = generator.getHTTPProxyConfigImpl(v1.HTTPProxiesSpec{Role: "default"})
+	g.Expect(err).Should(Succeed())
+	g.Expect(c.Auth.OidcAuthenticator).Should(BeNil())
+	g.Expect(c.Auth.LdapAuthenticator).ShouldNot(BeNil())
+
+	data, err = generator.GetUIClustersConfig()
+	g.Expect(err).Should(Succeed())
+	clusters = UIClusters{}
+	g.Expect(yson.Unmarshal(data, &clusters)).Should(Succeed())
+	g.Expect(clusters.Clusters[0].Authentication).Should(Equal(uiAuthenticationBasic))
+
+	data, err = generator.GetUICustomConfig()
+	g.Expect(err).Should(Succeed())
+	custom = UICustom{}
+	g.Expect(yson.Unmarshal(data, &custom)).Should(Succeed())
+	g.Expect(custom.OAuthSettings).Should(BeNil())
+}
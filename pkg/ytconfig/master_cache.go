@@ -0,0 +1,28 @@
+package ytconfig
+
+import (
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+)
+
+type MasterCacheServer struct {
+	CommonServer
+}
+
+func getMasterCacheLogging(spec ytv1.MasterCachesSpec) Logging {
+	return createLogging(
+		&spec.InstanceSpec,
+		"master-cache",
+		[]ytv1.TextLoggerSpec{defaultInfoLoggerSpec(), defaultStderrLoggerSpec()})
+}
+
+func getMasterCacheServerCarcass(spec ytv1.MasterCachesSpec) (MasterCacheServer, error) {
+	var c MasterCacheServer
+
+	c.MonitoringPort = consts.MasterCacheMonitoringPort
+	c.RPCPort = consts.MasterCacheRPCPort
+
+	c.Logging = getMasterCacheLogging(spec)
+
+	return c, nil
+}
@@ -13,6 +13,10 @@ func (g *Generator) getName(shortName string) string {
 	}
 }
 
+func (g *Generator) GetAdminCredentialsSecretName() string {
+	return g.getName("admin-credentials")
+}
+
 func (g *Generator) GetMastersStatefulSetName() string {
 	return g.getName("ms")
 }
@@ -21,6 +25,14 @@ func (g *Generator) GetDiscoveryStatefulSetName() string {
 	return g.getName("ds")
 }
 
+func (g *Generator) GetMasterCachesStatefulSetName() string {
+	return g.getName("msc")
+}
+
+func (g *Generator) GetTimestampProvidersStatefulSetName() string {
+	return g.getName("tsp")
+}
+
 func (g *Generator) GetYQLAgentStatefulSetName() string {
 	return g.getName("yqla")
 }
@@ -33,6 +45,14 @@ func (g *Generator) GetDiscoveryServiceName() string {
 	return g.getName("discovery")
 }
 
+func (g *Generator) GetMasterCachesServiceName() string {
+	return g.getName("master-caches")
+}
+
+func (g *Generator) GetTimestampProvidersServiceName() string {
+	return g.getName("timestamp-providers")
+}
+
 func (g *Generator) GetYQLAgentServiceName() string {
 	return g.getName("yql-agents")
 }
@@ -55,6 +75,24 @@ func (g *Generator) GetDiscoveryPodNames() []string {
 	return podNames
 }
 
+func (g *Generator) GetMasterCachePodNames() []string {
+	podNames := make([]string, 0, g.ytsaurus.Spec.MasterCaches.InstanceSpec.InstanceCount)
+	for i := 0; i < int(g.ytsaurus.Spec.MasterCaches.InstanceSpec.InstanceCount); i++ {
+		podNames = append(podNames, fmt.Sprintf("%s-%d", g.GetMasterCachesStatefulSetName(), i))
+	}
+
+	return podNames
+}
+
+func (g *Generator) GetTimestampProviderPodNames() []string {
+	podNames := make([]string, 0, g.ytsaurus.Spec.TimestampProviders.InstanceSpec.InstanceCount)
+	for i := 0; i < int(g.ytsaurus.Spec.TimestampProviders.InstanceSpec.InstanceCount); i++ {
+		podNames = append(podNames, fmt.Sprintf("%s-%d", g.GetTimestampProvidersStatefulSetName(), i))
+	}
+
+	return podNames
+}
+
 func (g *Generator) GetYQLAgentPodNames() []string {
 	podNames := make([]string, 0, g.ytsaurus.Spec.YQLAgents.InstanceSpec.InstanceCount)
 	for i := 0; i < int(g.ytsaurus.Spec.YQLAgents.InstanceSpec.InstanceCount); i++ {
@@ -101,6 +139,10 @@ func (g *Generator) GetHTTPProxiesAddress(role string) string {
 		g.clusterDomain)
 }
 
+func (g *Generator) GetControllerAgentsStatefulSetName() string {
+	return "ca"
+}
+
 func (g *Generator) GetSchedulerStatefulSetName() string {
 	return g.getName("sch")
 }
@@ -141,6 +183,56 @@ func (g *Generator) GetQueryTrackerServiceName() string {
 	return g.getName("query-trackers")
 }
 
+func (g *Generator) GetQueueAgentStatefulSetName() string {
+	return g.getName("qa")
+}
+
+func (g *Generator) GetQueueAgentServiceName() string {
+	return g.getName("queue-agents")
+}
+
+func (g *Generator) GetQueueAgentPodNames() []string {
+	podNames := make([]string, 0, g.ytsaurus.Spec.QueueAgents.InstanceSpec.InstanceCount)
+	for i := 0; i < int(g.ytsaurus.Spec.QueueAgents.InstanceSpec.InstanceCount); i++ {
+		podNames = append(podNames, fmt.Sprintf("%s-%d", g.GetQueueAgentStatefulSetName(), i))
+	}
+
+	return podNames
+}
+
+func (g *Generator) GetTabletBalancerStatefulSetName() string {
+	return g.getName("tb")
+}
+
+func (g *Generator) GetTabletBalancerServiceName() string {
+	return g.getName("tablet-balancers")
+}
+
+func (g *Generator) GetCellBalancerStatefulSetName() string {
+	return g.getName("cb")
+}
+
+func (g *Generator) GetCellBalancerServiceName() string {
+	return g.getName("cell-balancers")
+}
+
+func (g *Generator) GetReplicatedTableTrackerStatefulSetName() string {
+	return g.getName("rtt")
+}
+
+func (g *Generator) GetReplicatedTableTrackerServiceName() string {
+	return g.getName("replicated-table-trackers")
+}
+
+func (g *Generator) GetReplicatedTableTrackerPodNames() []string {
+	podNames := make([]string, 0, g.ytsaurus.Spec.ReplicatedTableTrackers.InstanceSpec.InstanceCount)
+	for i := 0; i < int(g.ytsaurus.Spec.ReplicatedTableTrackers.InstanceSpec.InstanceCount); i++ {
+		podNames = append(podNames, fmt.Sprintf("%s-%d", g.GetReplicatedTableTrackerStatefulSetName(), i))
+	}
+
+	return podNames
+}
+
 func (g *Generator) GetDataNodesStatefulSetName(name string) string {
 	return g.getName(g.FormatComponentStringWithDefault("dnd", name))
 }
@@ -171,3 +263,83 @@ func (g *Generator) FormatComponentStringWithDefault(base string, name string) s
 	}
 	return base
 }
+
+func (g *Generator) getServiceDNSNames(serviceName string) []string {
+	return []string{
+		serviceName,
+		fmt.Sprintf("%s.%s", serviceName, g.ytsaurus.Namespace),
+		fmt.Sprintf("%s.%s.svc", serviceName, g.ytsaurus.Namespace),
+		fmt.Sprintf("%s.%s.svc.%s", serviceName, g.ytsaurus.Namespace, g.clusterDomain),
+	}
+}
+
+// getHeadlessServiceDNSNames uses a wildcard for pods, so certificates do not depend on the instance count.
+func (g *Generator) getHeadlessServiceDNSNames(serviceName string) []string {
+	return []string{
+		fmt.Sprintf("%s.%s.svc.%s", serviceName, g.ytsaurus.Namespace, g.clusterDomain),
+		fmt.Sprintf("*.%s.%s.svc.%s", serviceName, g.ytsaurus.Namespace, g.clusterDomain),
+	}
+}
+
+func (g *Generator) GetHTTPProxiesDNSNames(role string) []string {
+	return append(
+		g.getServiceDNSNames(g.GetHTTPProxiesServiceName(role)),
+		g.getHeadlessServiceDNSNames(g.GetHTTPProxiesHeadlessServiceName(role))...)
+}
+
+func (g *Generator) GetRPCProxiesDNSNames(role string) []string {
+	return append(
+		g.getServiceDNSNames(g.GetRPCProxiesServiceName(role)),
+		g.getHeadlessServiceDNSNames(g.GetRPCProxiesHeadlessServiceName(role))...)
+}
+
+// GetStatefulSetNames returns the names of stateful sets of all components in the spec.
+func (g *Generator) GetStatefulSetNames() []string {
+	spec := g.ytsaurus.Spec
+	names := []string{
+		g.GetMastersStatefulSetName(),
+		g.GetDiscoveryStatefulSetName(),
+	}
+
+	for _, hp := range spec.HTTPProxies {
+		names = append(names, g.GetHTTPProxiesStatefulSetName(hp.Role))
+	}
+	for _, rp := range spec.RPCProxies {
+		names = append(names, g.GetRPCProxiesStatefulSetName(rp.Role))
+	}
+	for _, tp := range spec.TCPProxies {
+		names = append(names, g.GetTCPProxiesStatefulSetName(tp.Role))
+	}
+	for _, dn := range spec.DataNodes {
+		names = append(names, g.GetDataNodesStatefulSetName(dn.Name))
+	}
+	for _, en := range spec.ExecNodes {
+		names = append(names, g.GetExecNodesStatefulSetName(en.Name))
+	}
+	for _, tn := range spec.TabletNodes {
+		names = append(names, g.GetTabletNodesStatefulSetName(tn.Name))
+	}
+
+	optional := []struct {
+		enabled bool
+		name    string
+	}{
+		{spec.MasterCaches != nil, g.GetMasterCachesStatefulSetName()},
+		{spec.TimestampProviders != nil, g.GetTimestampProvidersStatefulSetName()},
+		{spec.Schedulers != nil, g.GetSchedulerStatefulSetName()},
+		{spec.ControllerAgents != nil, g.GetControllerAgentsStatefulSetName()},
+		{spec.QueryTrackers != nil, g.GetQueryTrackerStatefulSetName()},
+		{spec.QueueAgents != nil, g.GetQueueAgentStatefulSetName()},
+		{spec.YQLAgents != nil, g.GetYQLAgentStatefulSetName()},
+		{spec.TabletBalancers != nil, g.GetTabletBalancerStatefulSetName()},
+		{spec.CellBalancers != nil, g.GetCellBalancerStatefulSetName()},
+		{spec.ReplicatedTableTrackers != nil, g.GetReplicatedTableTrackerStatefulSetName()},
+	}
+	for _, component := range optional {
+		if component.enabled {
+			names = append(names, component.name)
+		}
+	}
+
+	return names
+}
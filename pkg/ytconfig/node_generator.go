@@ -0,0 +1,102 @@
+package ytconfig
+
+import (
+	"fmt"
+
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"go.ytsaurus.tech/yt/go/yson"
+)
+
+// NodeGenerator generates configs of nodes which are attached to an existing cluster
+// by its connection, e.g. nodes running in another namespace or Kubernetes cluster.
+type NodeGenerator struct {
+	name              string
+	commonSpec        *ytv1.CommonSpec
+	internalTransport *ytv1.InternalTransportSpec
+	clusterConnection ClusterConnection
+}
+
+// NewRemoteNodeGenerator parses the cluster connection in the format produced by GetClusterConnection.
+func NewRemoteNodeGenerator(
+	name string,
+	commonSpec *ytv1.CommonSpec,
+	internalTransport *ytv1.InternalTransportSpec,
+	clusterConnection []byte) (*NodeGenerator, error) {
+	g := &NodeGenerator{
+		name:              name,
+		commonSpec:        commonSpec,
+		internalTransport: internalTransport,
+	}
+
+	if err := yson.Unmarshal(clusterConnection, &g.clusterConnection); err != nil {
+		return nil, fmt.Errorf("failed to parse cluster connection: %w", err)
+	}
+	if len(g.clusterConnection.PrimaryMaster.Addresses) == 0 {
+		return nil, fmt.Errorf("cluster connection has no primary master addresses")
+	}
+
+	return g, nil
+}
+
+// GetClusterName returns the name of the cluster the nodes are attached to.
+func (g *NodeGenerator) GetClusterName() string {
+	return g.clusterConnection.ClusterName
+}
+
+// Remote nodes may share a namespace with a Ytsaurus, so their names always include the resource name.
+func (g *NodeGenerator) getName(shortName string) string {
+	return fmt.Sprintf("%s-%s", shortName, g.name)
+}
+
+func (g *NodeGenerator) GetExecNodesStatefulSetName() string {
+	return g.getName("rend")
+}
+
+func (g *NodeGenerator) GetExecNodesServiceName() string {
+	return g.getName("remote-exec-nodes")
+}
+
+func (g *NodeGenerator) GetDataNodesStatefulSetName() string {
+	return g.getName("rdnd")
+}
+
+func (g *NodeGenerator) GetDataNodesServiceName() string {
+	return g.getName("remote-data-nodes")
+}
+
+// GetInternalTransportEncryptionMode returns the mode from spec, there are no steps
+// for remote nodes, since they have no peers besides the cluster.
+func (g *NodeGenerator) GetInternalTransportEncryptionMode() ytv1.BusEncryptionMode {
+	if g.internalTransport == nil {
+		return ytv1.BusEncryptionModeDisabled
+	}
+	if g.internalTransport.EncryptionMode == "" {
+		return ytv1.BusEncryptionModeOptional
+	}
+	return g.internalTransport.EncryptionMode
+}
+
+func (g *NodeGenerator) fillCommonService(c *CommonServer) {
+	fillAddressResolver(&c.AddressResolver, g.commonSpec)
+	c.ClusterConnection = g.clusterConnection
+	c.TimestampProviders.Addresses = g.clusterConnection.PrimaryMaster.Addresses
+	fillInternalTransport(&c.BasicServer, getEncryptionMode(g.GetInternalTransportEncryptionMode()), g.internalTransport)
+}
+
+func (g *NodeGenerator) GetExecNodeConfig(spec ytv1.ExecNodesSpec) ([]byte, error) {
+	c, err := getExecNodeServerCarcass(spec, g.commonSpec.UsePorto)
+	if err != nil {
+		return nil, err
+	}
+	g.fillCommonService(&c.CommonServer)
+	return marshallYsonConfig(c)
+}
+
+func (g *NodeGenerator) GetDataNodeConfig(spec ytv1.DataNodesSpec) ([]byte, error) {
+	c, err := getDataNodeServerCarcass(spec)
+	if err != nil {
+		return nil, err
+	}
+	g.fillCommonService(&c.CommonServer)
+	return marshallYsonConfig(c)
+}
@@ -25,9 +25,33 @@ type OauthService struct {
 type OauthCookieAuthenticator struct{}
 type OauthTokenAuthenticator struct{}
 
+type OidcAuthenticator struct {
+	Issuer           string            `yson:"issuer"`
+	ClientID         string            `yson:"client_id"`
+	ClientSecretFile string            `yson:"client_secret_file"`
+	Scopes           []string          `yson:"scopes"`
+	LoginClaim       string            `yson:"login_claim"`
+	GroupsClaim      string            `yson:"groups_claim,omitempty"`
+	GroupMapping     map[string]string `yson:"group_mapping,omitempty"`
+}
+
+type LdapAuthenticator struct {
+	Host             string            `yson:"host"`
+	Port             int               `yson:"port"`
+	Secure           bool              `yson:"secure"`
+	BindDN           string            `yson:"bind_dn"`
+	BindPasswordFile string            `yson:"bind_password_file"`
+	UserSearchBase   string            `yson:"user_search_base"`
+	UserFilter       string            `yson:"user_filter"`
+	GroupSearchBase  string            `yson:"group_search_base,omitempty"`
+	GroupMapping     map[string]string `yson:"group_mapping,omitempty"`
+}
+
 type Coordinator struct {
 	Enable            bool   `yson:"enable"`
 	DefaultRoleFilter string `yson:"default_role_filter"`
+	// PublicFQDN is announced to the clients instead of the pod address.
+	PublicFQDN string `yson:"public_fqdn,omitempty"`
 }
 
 type Auth struct {
@@ -37,6 +61,8 @@ type Auth struct {
 	OauthService              *OauthService             `yson:"oauth_service,omitempty"`
 	OauthCookieAuthenticator  *OauthCookieAuthenticator `yson:"oauth_cookie_authenticator,omitempty"`
 	OauthTokenAuthenticator   *OauthTokenAuthenticator  `yson:"oauth_token_authenticator,omitempty"`
+	OidcAuthenticator         *OidcAuthenticator        `yson:"oidc_authenticator,omitempty"`
+	LdapAuthenticator         *LdapAuthenticator        `yson:"ldap_authenticator,omitempty"`
 	RequireAuthentication     bool                      `yson:"require_authentication"`
 }
 
@@ -68,6 +94,7 @@ type NativeClient struct {
 	AddressResolver AddressResolver `yson:"address_resolver"`
 	Logging         Logging         `yson:"logging"`
 	Driver          Driver          `yson:"driver"`
+	BusClient       *Bus            `yson:"bus_client,omitempty"`
 }
 
 type RPCProxyServer struct {
@@ -106,6 +133,10 @@ func getHTTPProxyServerCarcass(spec ytv1.HTTPProxiesSpec) (HTTPProxyServer, erro
 
 	c.Role = spec.Role
 
+	if spec.ExternalAccess != nil {
+		c.Coordinator.PublicFQDN = spec.ExternalAccess.Hostname
+	}
+
 	c.Logging = getHTTPProxyLogging(spec)
 
 	// FIXME handle DisableHTTP
@@ -129,6 +160,34 @@ func getHTTPProxyServerCarcass(spec ytv1.HTTPProxiesSpec) (HTTPProxyServer, erro
 	return c, nil
 }
 
+// getOidcAuthenticator reads the client secret from the file mounted into the proxy pods.
+func getOidcAuthenticator(spec ytv1.OidcSpec) *OidcAuthenticator {
+	return &OidcAuthenticator{
+		Issuer:           spec.Issuer,
+		ClientID:         spec.ClientID,
+		ClientSecretFile: path.Join(consts.OidcSecretMountPoint, spec.ClientSecret.Key),
+		Scopes:           spec.Scopes,
+		LoginClaim:       spec.LoginClaim,
+		GroupsClaim:      spec.GroupsClaim,
+		GroupMapping:     spec.GroupMapping,
+	}
+}
+
+// getLdapAuthenticator reads the bind password from the file mounted into the proxy pods.
+func getLdapAuthenticator(spec ytv1.LdapSpec) *LdapAuthenticator {
+	return &LdapAuthenticator{
+		Host:             spec.Host,
+		Port:             spec.Port,
+		Secure:           spec.Secure,
+		BindDN:           spec.BindDN,
+		BindPasswordFile: path.Join(consts.LdapSecretMountPoint, spec.BindPassword.Key),
+		UserSearchBase:   spec.UserSearchBase,
+		UserFilter:       spec.UserFilter,
+		GroupSearchBase:  spec.GroupSearchBase,
+		GroupMapping:     spec.GroupMapping,
+	}
+}
+
 func getRPCProxyLogging(spec ytv1.RPCProxiesSpec) Logging {
 	return createLogging(
 		&spec.InstanceSpec,
@@ -0,0 +1,43 @@
+package ytconfig
+
+import (
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+)
+
+type QueueAgent struct {
+	Stage string `yson:"stage"`
+}
+
+type CypressSynchronizer struct {
+	Policy string `yson:"policy"`
+}
+
+type QueueAgentServer struct {
+	CommonServer
+	User                string              `yson:"user"`
+	QueueAgent          QueueAgent          `yson:"queue_agent"`
+	CypressSynchronizer CypressSynchronizer `yson:"cypress_synchronizer"`
+}
+
+func getQueueAgentLogging(spec ytv1.QueueAgentSpec) Logging {
+	return createLogging(
+		&spec.InstanceSpec,
+		"queue-agent",
+		[]ytv1.TextLoggerSpec{defaultInfoLoggerSpec(), defaultStderrLoggerSpec()})
+}
+
+func getQueueAgentServerCarcass(spec ytv1.QueueAgentSpec) (QueueAgentServer, error) {
+	var c QueueAgentServer
+	c.RPCPort = consts.QueueAgentRPCPort
+	c.MonitoringPort = consts.QueueAgentMonitoringPort
+
+	c.User = consts.QueueAgentUserName
+	c.QueueAgent.Stage = "production"
+	// Queues and consumers are discovered by watching their attributes in Cypress.
+	c.CypressSynchronizer.Policy = "watching"
+
+	c.Logging = getQueueAgentLogging(spec)
+
+	return c, nil
+}
@@ -0,0 +1,27 @@
+package ytconfig
+
+import (
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+)
+
+type ReplicatedTableTrackerServer struct {
+	CommonServer
+}
+
+func getReplicatedTableTrackerLogging(spec ytv1.ReplicatedTableTrackerSpec) Logging {
+	return createLogging(
+		&spec.InstanceSpec,
+		"replicated-table-tracker",
+		[]ytv1.TextLoggerSpec{defaultInfoLoggerSpec(), defaultStderrLoggerSpec()})
+}
+
+func getReplicatedTableTrackerServerCarcass(spec ytv1.ReplicatedTableTrackerSpec) (ReplicatedTableTrackerServer, error) {
+	var c ReplicatedTableTrackerServer
+	c.RPCPort = consts.ReplicatedTableTrackerRPCPort
+	c.MonitoringPort = consts.ReplicatedTableTrackerMonitoringPort
+
+	c.Logging = getReplicatedTableTrackerLogging(spec)
+
+	return c, nil
+}
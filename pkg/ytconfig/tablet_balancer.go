@@ -0,0 +1,34 @@
+package ytconfig
+
+import (
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+)
+
+const TabletBalancerRootPath = "//sys/tablet_balancer"
+
+type TabletBalancerServer struct {
+	CommonServer
+	ClusterUser string `yson:"cluster_user"`
+	RootPath    string `yson:"root_path"`
+}
+
+func getTabletBalancerLogging(spec ytv1.TabletBalancersSpec) Logging {
+	return createLogging(
+		&spec.InstanceSpec,
+		"tablet-balancer",
+		[]ytv1.TextLoggerSpec{defaultInfoLoggerSpec(), defaultStderrLoggerSpec()})
+}
+
+func getTabletBalancerServerCarcass(spec ytv1.TabletBalancersSpec) (TabletBalancerServer, error) {
+	var c TabletBalancerServer
+	c.RPCPort = consts.TabletBalancerRPCPort
+	c.MonitoringPort = consts.TabletBalancerMonitoringPort
+
+	c.ClusterUser = consts.TabletBalancerUserName
+	c.RootPath = TabletBalancerRootPath
+
+	c.Logging = getTabletBalancerLogging(spec)
+
+	return c, nil
+}
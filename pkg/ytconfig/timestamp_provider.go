@@ -0,0 +1,28 @@
+package ytconfig
+
+import (
+	ytv1 "github.com/ytsaurus/yt-k8s-operator/api/v1"
+	"github.com/ytsaurus/yt-k8s-operator/pkg/consts"
+)
+
+type TimestampProviderServer struct {
+	CommonServer
+}
+
+func getTimestampProviderLogging(spec ytv1.TimestampProvidersSpec) Logging {
+	return createLogging(
+		&spec.InstanceSpec,
+		"timestamp-provider",
+		[]ytv1.TextLoggerSpec{defaultInfoLoggerSpec(), defaultStderrLoggerSpec()})
+}
+
+func getTimestampProviderServerCarcass(spec ytv1.TimestampProvidersSpec) (TimestampProviderServer, error) {
+	var c TimestampProviderServer
+
+	c.MonitoringPort = consts.TimestampProviderMonitoringPort
+	c.RPCPort = consts.TimestampProviderRPCPort
+
+	c.Logging = getTimestampProviderLogging(spec)
+
+	return c, nil
+}
@@ -4,6 +4,7 @@ type UIAuthenticationType string
 
 const (
 	uiAuthenticationBasic UIAuthenticationType = "basic"
+	uiAuthenticationOAuth UIAuthenticationType = "oauth"
 )
 
 type UIPrimaryMaster struct {
@@ -14,6 +15,7 @@ type UICluster struct {
 	ID             string               `yson:"id"`
 	Name           string               `yson:"name"`
 	Proxy          string               `yson:"proxy"`
+	ExternalProxy  string               `yson:"externalProxy,omitempty"`
 	Secure         bool                 `yson:"secure"`
 	Authentication UIAuthenticationType `yson:"authentication"`
 	Group          string               `yson:"group"`
@@ -36,6 +38,13 @@ func getUIClusterCarcass() UICluster {
 	}
 }
 
+type UIOAuthSettings struct {
+	BaseURL  string `yson:"baseURL"`
+	ClientID string `yson:"clientId"`
+	Scope    string `yson:"scope"`
+}
+
 type UICustom struct {
-	OdinBaseUrl *string `yson:"odinBaseUrl,omitempty"`
+	OdinBaseUrl   *string          `yson:"odinBaseUrl,omitempty"`
+	OAuthSettings *UIOAuthSettings `yson:"ytOAuthSettings,omitempty"`
 }